@@ -31,6 +31,7 @@ type GitCommand struct {
 	Patch       *git_commands.PatchCommands
 	Rebase      *git_commands.RebaseCommands
 	Remote      *git_commands.RemoteCommands
+	Rerere      *git_commands.RerereCommands
 	Stash       *git_commands.StashCommands
 	Status      *git_commands.StatusCommands
 	Submodule   *git_commands.SubmoduleCommands
@@ -103,11 +104,11 @@ func NewGitCommandAux(
 
 	statusCommands := git_commands.NewStatusCommands(gitCommon)
 	fileLoader := loaders.NewFileLoader(cmn, cmd, configCommands)
-	flowCommands := git_commands.NewFlowCommands(gitCommon)
 	remoteCommands := git_commands.NewRemoteCommands(gitCommon)
 	branchCommands := git_commands.NewBranchCommands(gitCommon)
 	syncCommands := git_commands.NewSyncCommands(gitCommon)
 	tagCommands := git_commands.NewTagCommands(gitCommon)
+	flowCommands := git_commands.NewFlowCommands(gitCommon, branchCommands, tagCommands)
 	commitCommands := git_commands.NewCommitCommands(gitCommon)
 	customCommands := git_commands.NewCustomCommands(gitCommon)
 	fileCommands := git_commands.NewFileCommands(gitCommon)
@@ -119,6 +120,7 @@ func NewGitCommandAux(
 	patchManager := patch.NewPatchManager(cmn.Log, workingTreeCommands.ApplyPatch, workingTreeCommands.ShowFileDiff)
 	patchCommands := git_commands.NewPatchCommands(gitCommon, rebaseCommands, commitCommands, statusCommands, stashCommands, patchManager)
 	bisectCommands := git_commands.NewBisectCommands(gitCommon)
+	rerereCommands := git_commands.NewRerereCommands(gitCommon)
 
 	return &GitCommand{
 		Branch:      branchCommands,
@@ -130,6 +132,7 @@ func NewGitCommandAux(
 		Patch:       patchCommands,
 		Rebase:      rebaseCommands,
 		Remote:      remoteCommands,
+		Rerere:      rerereCommands,
 		Stash:       stashCommands,
 		Status:      statusCommands,
 		Submodule:   submoduleCommands,
@@ -138,9 +141,9 @@ func NewGitCommandAux(
 		Bisect:      bisectCommands,
 		WorkingTree: workingTreeCommands,
 		Loaders: Loaders{
-			Branches:      loaders.NewBranchLoader(cmn, branchCommands.GetRawBranches, branchCommands.CurrentBranchName, configCommands),
+			Branches:      loaders.NewBranchLoader(cmn, branchCommands.GetRawBranches, branchCommands.CurrentBranchName, branchCommands.GetBaseBranch, branchCommands.GetCommitDifferences, configCommands),
 			CommitFiles:   loaders.NewCommitFileLoader(cmn, cmd),
-			Commits:       loaders.NewCommitLoader(cmn, cmd, dotGitDir, branchCommands.CurrentBranchName, statusCommands.RebaseMode),
+			Commits:       loaders.NewCommitLoader(cmn, cmd, dotGitDir, branchCommands.GetBaseBranch, statusCommands.RebaseMode),
 			Files:         fileLoader,
 			ReflogCommits: loaders.NewReflogCommitLoader(cmn, cmd),
 			Remotes:       loaders.NewRemoteLoader(cmn, cmd, repo.Remotes),
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -149,24 +150,128 @@ func (self *BranchCommands) GetRawBranches() (string, error) {
 	return self.cmd.New(`git for-each-ref --sort=-committerdate --format="%(HEAD)%00%(refname:short)%00%(upstream:short)%00%(upstream:track)" refs/heads`).DontLog().RunWithOutput()
 }
 
+// MergedBranches returns the names of the local branches whose tips are reachable
+// from the given ref, i.e. which have been merged into it
+func (self *BranchCommands) MergedBranches(refName string) ([]string, error) {
+	output, err := self.cmd.New(
+		fmt.Sprintf(`git for-each-ref --merged=%s --format="%%(refname)" refs/heads`, self.cmd.Quote(refName)),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Map(utils.SplitLines(output), func(line string) string {
+		return strings.TrimPrefix(line, "refs/heads/")
+	}), nil
+}
+
+// the git config key under which a repo's base branch is stored
+const baseBranchConfigKey = "lazygit.baseBranch"
+
+// GetBaseBranch returns the ref that branches are compared against to work out
+// how far they've diverged and which of their commits have already landed. In
+// order of preference this is the base branch set for the repo, the one set in
+// the user config, the remote's default branch (origin/HEAD), and finally the
+// first of the configured main branches that exists locally. Returns an empty
+// string if none of these can be found.
+func (self *BranchCommands) GetBaseBranch() string {
+	output, err := self.cmd.New(fmt.Sprintf("git config --local --get %s", baseBranchConfigKey)).DontLog().RunWithOutput()
+	if err == nil && strings.TrimSpace(output) != "" {
+		return strings.TrimSpace(output)
+	}
+
+	if self.UserConfig.Git.BaseBranch != "" {
+		return self.UserConfig.Git.BaseBranch
+	}
+
+	output, err = self.cmd.New("git symbolic-ref --short refs/remotes/origin/HEAD").DontLog().RunWithOutput()
+	if err == nil && strings.TrimSpace(output) != "" {
+		return strings.TrimSpace(output)
+	}
+
+	for _, branchName := range self.UserConfig.Git.MainBranches {
+		err := self.cmd.New(
+			fmt.Sprintf("git rev-parse --verify --quiet %s", self.cmd.Quote("refs/heads/"+branchName)),
+		).DontLog().Run()
+		if err == nil {
+			return branchName
+		}
+	}
+
+	return ""
+}
+
+// SetBaseBranch sets the base branch for the current repo. Passing an empty string
+// goes back to the default.
+func (self *BranchCommands) SetBaseBranch(refName string) error {
+	if refName == "" {
+		return self.cmd.New(fmt.Sprintf("git config --local --unset %s", baseBranchConfigKey)).Run()
+	}
+
+	return self.cmd.New(fmt.Sprintf("git config --local %s %s", baseBranchConfigKey, self.cmd.Quote(refName))).Run()
+}
+
 type MergeOpts struct {
 	FastForwardOnly bool
+	NoFastForward   bool
+	// stages the merged changes without committing them (or moving HEAD) so that
+	// they can be committed as a single commit
+	Squash bool
+	// if empty, git's default merge message is used
+	Message string
+	// e.g. 'ort', 'recursive' or 'ours'. If empty, git picks the strategy
+	Strategy string
+	// passed to the strategy with -X, e.g. 'theirs' or 'ignore-space-change'
+	StrategyOptions []string
 }
 
 func (self *BranchCommands) Merge(branchName string, opts MergeOpts) error {
 	mergeArg := ""
-	if self.UserConfig.Git.Merging.Args != "" {
-		mergeArg = " " + self.UserConfig.Git.Merging.Args
+	for _, arg := range strings.Fields(self.UserConfig.Git.Merging.Args) {
+		// git refuses to combine --squash with --no-ff, and --ff-only has no
+		// bearing on a squash, so we leave them out in that case
+		if opts.Squash && (arg == "--no-ff" || arg == "--ff-only") {
+			continue
+		}
+		mergeArg += " " + arg
+	}
+	if opts.Strategy != "" {
+		mergeArg += " --strategy=" + opts.Strategy
+	}
+	for _, option := range opts.StrategyOptions {
+		mergeArg += " -X " + self.cmd.Quote(option)
+	}
+	if opts.Squash {
+		mergeArg += " --squash"
+	}
+	if opts.Message != "" {
+		mergeArg += " -m " + self.cmd.Quote(opts.Message)
 	}
 
 	command := fmt.Sprintf("git merge --no-edit%s %s", mergeArg, self.cmd.Quote(branchName))
 	if opts.FastForwardOnly {
 		command = fmt.Sprintf("%s --ff-only", command)
 	}
+	if opts.NoFastForward {
+		command = fmt.Sprintf("%s --no-ff", command)
+	}
 
 	return self.cmd.New(command).Run()
 }
 
+// SubjectsToMerge returns the subjects of the commits that merging the given
+// branch would bring into the checked out branch, oldest first
+func (self *BranchCommands) SubjectsToMerge(branchName string) ([]string, error) {
+	output, err := self.cmd.New(
+		fmt.Sprintf("git log --reverse --format=%%s HEAD..%s", self.cmd.Quote(branchName)),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
 func (self *BranchCommands) AllBranchesLogCmdObj() oscommands.ICmdObj {
 	return self.cmd.New(self.UserConfig.Git.AllBranchesLogCmd).DontLog()
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -99,11 +100,80 @@ func TestBranchDeleteBranch(t *testing.T) {
 }
 
 func TestBranchMerge(t *testing.T) {
+	type scenario struct {
+		testName  string
+		mergeArgs string
+		opts      MergeOpts
+		expected  string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "basic",
+			opts:     MergeOpts{},
+			expected: `git merge --no-edit "test"`,
+		},
+		{
+			testName: "no fast forward",
+			opts:     MergeOpts{NoFastForward: true},
+			expected: `git merge --no-edit "test" --no-ff`,
+		},
+		{
+			testName: "fast forward only",
+			opts:     MergeOpts{FastForwardOnly: true},
+			expected: `git merge --no-edit "test" --ff-only`,
+		},
+		{
+			testName: "squash",
+			opts:     MergeOpts{Squash: true},
+			expected: `git merge --no-edit --squash "test"`,
+		},
+		{
+			testName: "message",
+			opts:     MergeOpts{Message: "my message"},
+			expected: `git merge --no-edit -m "my message" "test"`,
+		},
+		{
+			testName: "strategy",
+			opts:     MergeOpts{Strategy: "recursive", StrategyOptions: []string{"theirs", "ignore-space-change"}},
+			expected: `git merge --no-edit --strategy=recursive -X "theirs" -X "ignore-space-change" "test"`,
+		},
+		{
+			testName:  "merging args",
+			mergeArgs: "--no-ff --verbose",
+			opts:      MergeOpts{},
+			expected:  `git merge --no-edit --no-ff --verbose "test"`,
+		},
+		{
+			testName:  "squash ignores fast forward merging args",
+			mergeArgs: "--no-ff --verbose --ff-only",
+			opts:      MergeOpts{Squash: true},
+			expected:  `git merge --no-edit --verbose --squash "test"`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).Expect(s.expected, "", nil)
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.Merging.Args = s.mergeArgs
+			instance := buildBranchCommands(commonDeps{runner: runner, userConfig: userConfig})
+
+			assert.NoError(t, instance.Merge("test", s.opts))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBranchSubjectsToMerge(t *testing.T) {
 	runner := oscommands.NewFakeRunner(t).
-		Expect(`git merge --no-edit "test"`, "", nil)
+		Expect(`git log --reverse --format=%s HEAD.."feature"`, "first\nsecond\n", nil)
 	instance := buildBranchCommands(commonDeps{runner: runner})
 
-	assert.NoError(t, instance.Merge("test", MergeOpts{}))
+	subjects, err := instance.SubjectsToMerge("feature")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, subjects)
 	runner.CheckForMissingCalls()
 }
 
@@ -223,3 +293,88 @@ func TestBranchCurrentBranchName(t *testing.T) {
 		})
 	}
 }
+
+func TestBranchMergedBranches(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git for-each-ref --merged="main" --format="%(refname)" refs/heads`, "refs/heads/main\nrefs/heads/feature/done\n", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	branches, err := instance.MergedBranches("main")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"main", "feature/done"}, branches)
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchGetBaseBranch(t *testing.T) {
+	type scenario struct {
+		testName   string
+		runner     *oscommands.FakeCmdObjRunner
+		baseBranch string
+		expected   string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "set for the repo",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git config --local --get lazygit.baseBranch`, "origin/develop\n", nil),
+			baseBranch: "main",
+			expected:   "origin/develop",
+		},
+		{
+			testName: "set in the user config",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git config --local --get lazygit.baseBranch`, "", errors.New("error")),
+			baseBranch: "main",
+			expected:   "main",
+		},
+		{
+			testName: "remote default branch",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git config --local --get lazygit.baseBranch`, "", errors.New("error")).
+				Expect(`git symbolic-ref --short refs/remotes/origin/HEAD`, "origin/trunk\n", nil),
+			expected: "origin/trunk",
+		},
+		{
+			testName: "first main branch that exists",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git config --local --get lazygit.baseBranch`, "", errors.New("error")).
+				Expect(`git symbolic-ref --short refs/remotes/origin/HEAD`, "", errors.New("error")).
+				Expect(`git rev-parse --verify --quiet "refs/heads/master"`, "", errors.New("error")).
+				Expect(`git rev-parse --verify --quiet "refs/heads/main"`, "", nil),
+			expected: "main",
+		},
+		{
+			testName: "nothing found",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git config --local --get lazygit.baseBranch`, "", errors.New("error")).
+				Expect(`git symbolic-ref --short refs/remotes/origin/HEAD`, "", errors.New("error")).
+				Expect(`git rev-parse --verify --quiet "refs/heads/master"`, "", errors.New("error")).
+				Expect(`git rev-parse --verify --quiet "refs/heads/main"`, "", errors.New("error")),
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.BaseBranch = s.baseBranch
+			instance := buildBranchCommands(commonDeps{runner: s.runner, userConfig: userConfig})
+
+			assert.Equal(t, s.expected, instance.GetBaseBranch())
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBranchSetBaseBranch(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git config --local lazygit.baseBranch "origin/develop"`, "", nil).
+		Expect(`git config --local --unset lazygit.baseBranch`, "", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetBaseBranch("origin/develop"))
+	assert.NoError(t, instance.SetBaseBranch(""))
+	runner.CheckForMissingCalls()
+}
@@ -149,6 +149,12 @@ func (self *CommitCommands) ShowCmdObj(sha string, filterPath string) oscommands
 	return self.cmd.New(cmdStr).DontLog()
 }
 
+// VerifyCmdObj returns a command which prints the result of verifying the
+// commit's GPG or SSH signature
+func (self *CommitCommands) VerifyCmdObj(sha string) oscommands.ICmdObj {
+	return self.cmd.New(fmt.Sprintf("git verify-commit %s", sha)).DontLog()
+}
+
 // Revert reverts the selected commit by sha
 func (self *CommitCommands) Revert(sha string) error {
 	return self.cmd.New(fmt.Sprintf("git revert %s", sha)).Run()
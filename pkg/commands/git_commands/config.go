@@ -68,6 +68,17 @@ func (self *ConfigCommands) UsingGpg() bool {
 	return self.gitConfig.GetBool("commit.gpgsign")
 }
 
+// GetSigningFormat returns the format used for signing commits and tags: one of
+// openpgp (the default), x509, or ssh
+func (self *ConfigCommands) GetSigningFormat() string {
+	format := self.gitConfig.Get("gpg.format")
+	if format == "" {
+		return "openpgp"
+	}
+
+	return format
+}
+
 func (self *ConfigCommands) GetCoreEditor() string {
 	return self.gitConfig.Get("core.editor")
 }
@@ -95,7 +106,3 @@ func (self *ConfigCommands) Branches() (map[string]*config.Branch, error) {
 
 	return conf.Branches, nil
 }
-
-func (self *ConfigCommands) GetGitFlowPrefixes() string {
-	return self.gitConfig.GetGeneral("--local --get-regexp gitflow.prefix")
-}
@@ -6,6 +6,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/loaders"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -96,6 +97,16 @@ func buildSubmoduleCommands(deps commonDeps) *SubmoduleCommands {
 	return NewSubmoduleCommands(gitCommon)
 }
 
+func buildFlowCommands(deps commonDeps) *FlowCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewFlowCommands(gitCommon, buildBranchCommands(deps), buildTagCommands(deps))
+}
+
+func buildRerereCommands(deps commonDeps) *RerereCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewRerereCommands(gitCommon)
+}
+
 func buildCommitCommands(deps commonDeps) *CommitCommands {
 	gitCommon := buildGitCommon(deps)
 	return NewCommitCommands(gitCommon)
@@ -125,6 +136,16 @@ func buildRebaseCommands(deps commonDeps) *RebaseCommands {
 	return NewRebaseCommands(gitCommon, commitCommands, workingTreeCommands)
 }
 
+func buildPatchCommands(deps commonDeps, patchManager *patch.PatchManager) *PatchCommands {
+	gitCommon := buildGitCommon(deps)
+	rebaseCommands := buildRebaseCommands(deps)
+	commitCommands := buildCommitCommands(deps)
+	statusCommands := NewStatusCommands(gitCommon)
+	stashCommands := buildStashCommands(deps)
+
+	return NewPatchCommands(gitCommon, rebaseCommands, commitCommands, statusCommands, stashCommands, patchManager)
+}
+
 func buildSyncCommands(deps commonDeps) *SyncCommands {
 	gitCommon := buildGitCommon(deps)
 
@@ -142,3 +163,15 @@ func buildBranchCommands(deps commonDeps) *BranchCommands {
 
 	return NewBranchCommands(gitCommon)
 }
+
+func buildTagCommands(deps commonDeps) *TagCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewTagCommands(gitCommon)
+}
+
+func buildRemoteCommands(deps commonDeps) *RemoteCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewRemoteCommands(gitCommon)
+}
@@ -1,56 +1,108 @@
 package git_commands
 
 import (
-	"regexp"
+	"fmt"
 	"strings"
 
 	"github.com/go-errors/errors"
-	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 )
 
+// FlowCommands implements a git-flow style branching model on top of plain git,
+// so that it works without the git-flow extension. The branch types and what
+// happens when a branch is started and finished come from the git.branchingModel
+// user config.
 type FlowCommands struct {
 	*GitCommon
+	branch *BranchCommands
+	tag    *TagCommands
 }
 
 func NewFlowCommands(
 	gitCommon *GitCommon,
+	branch *BranchCommands,
+	tag *TagCommands,
 ) *FlowCommands {
 	return &FlowCommands{
 		GitCommon: gitCommon,
+		branch:    branch,
+		tag:       tag,
 	}
 }
 
-func (self *FlowCommands) GitFlowEnabled() bool {
-	return self.config.GetGitFlowPrefixes() != ""
+func (self *FlowCommands) BranchTypes() []config.BranchTypeConfig {
+	return self.UserConfig.Git.BranchingModel.BranchTypes
 }
 
-func (self *FlowCommands) FinishCmdObj(branchName string) (oscommands.ICmdObj, error) {
-	prefixes := self.config.GetGitFlowPrefixes()
+// BranchTypeFor returns the type of the given branch, going by the longest prefix
+// that matches, or false if it isn't of any type
+func (self *FlowCommands) BranchTypeFor(branchName string) (config.BranchTypeConfig, bool) {
+	var match config.BranchTypeConfig
+	found := false
+	for _, branchType := range self.BranchTypes() {
+		if branchType.Prefix == "" || !strings.HasPrefix(branchName, branchType.Prefix) {
+			continue
+		}
+		if !found || len(branchType.Prefix) > len(match.Prefix) {
+			match = branchType
+			found = true
+		}
+	}
+
+	return match, found
+}
+
+// Start creates and checks out a branch of the given type, e.g. 'feature/my-thing'
+// from 'develop'
+func (self *FlowCommands) Start(branchType config.BranchTypeConfig, name string) error {
+	return self.branch.New(branchType.Prefix+name, branchType.Base)
+}
 
-	// need to find out what kind of branch this is
-	prefix := strings.SplitAfterN(branchName, "/", 2)[0]
-	suffix := strings.Replace(branchName, prefix, "", 1)
+// Finish merges the branch into each of its type's merge targets in turn, tagging
+// the first target if configured to, and then deletes the branch if configured to.
+// If a merge fails (e.g. due to conflicts) we stop there and leave the rest to the
+// user. We end up on the last merge target.
+func (self *FlowCommands) Finish(branchName string) error {
+	branchType, ok := self.BranchTypeFor(branchName)
+	if !ok {
+		return errors.New(self.Tr.NotAGitFlowBranch)
+	}
 
-	branchType := ""
-	for _, line := range strings.Split(strings.TrimSpace(prefixes), "\n") {
-		if strings.HasPrefix(line, "gitflow.prefix.") && strings.HasSuffix(line, prefix) {
-			regex := regexp.MustCompile("gitflow.prefix.([^ ]*) .*")
-			matches := regex.FindAllStringSubmatch(line, 1)
+	if len(branchType.MergeInto) == 0 {
+		return errors.New(fmt.Sprintf(self.Tr.NoMergeTargetsForBranchType, branchType.Name))
+	}
+
+	mergeOpts := MergeOpts{
+		FastForwardOnly: branchType.MergeStrategy == "ff-only",
+		NoFastForward:   branchType.MergeStrategy == "no-ff",
+	}
+
+	for i, target := range branchType.MergeInto {
+		if err := self.branch.Checkout(target, CheckoutOptions{}); err != nil {
+			return err
+		}
+
+		if err := self.branch.Merge(branchName, mergeOpts); err != nil {
+			return err
+		}
 
-			if len(matches) > 0 && len(matches[0]) > 1 {
-				branchType = matches[0][1]
-				break
+		if i == 0 && branchType.Tag {
+			tagMessage := fmt.Sprintf("%s %s", branchType.Name, strings.TrimPrefix(branchName, branchType.Prefix))
+			if err := self.tag.CreateAnnotated(self.TagName(branchType, branchName), target, tagMessage); err != nil {
+				return err
 			}
 		}
 	}
 
-	if branchType == "" {
-		return nil, errors.New(self.Tr.NotAGitFlowBranch)
+	if branchType.DeleteAfterFinish {
+		return self.branch.Delete(branchName, false)
 	}
 
-	return self.cmd.New("git flow " + branchType + " finish " + suffix), nil
+	return nil
 }
 
-func (self *FlowCommands) StartCmdObj(branchType string, name string) oscommands.ICmdObj {
-	return self.cmd.New("git flow " + branchType + " start " + name)
+// TagName returns the name of the tag created when finishing the branch, e.g. 'v1.2'
+// for 'release/1.2' with a tag prefix of 'v'
+func (self *FlowCommands) TagName(branchType config.BranchTypeConfig, branchName string) string {
+	return branchType.TagPrefix + strings.TrimPrefix(branchName, branchType.Prefix)
 }
@@ -0,0 +1,101 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/go-errors/errors"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlowBranchTypeFor(t *testing.T) {
+	userConfig := config.GetDefaultConfig()
+	userConfig.Git.BranchingModel.BranchTypes = append(
+		userConfig.Git.BranchingModel.BranchTypes,
+		config.BranchTypeConfig{Name: "experiment", Prefix: "feature/experiment/", Base: "develop"},
+	)
+	instance := buildFlowCommands(commonDeps{userConfig: userConfig})
+
+	branchType, ok := instance.BranchTypeFor("feature/login")
+	assert.True(t, ok)
+	assert.Equal(t, "feature", branchType.Name)
+
+	branchType, ok = instance.BranchTypeFor("feature/experiment/thing")
+	assert.True(t, ok)
+	assert.Equal(t, "experiment", branchType.Name)
+
+	_, ok = instance.BranchTypeFor("main")
+	assert.False(t, ok)
+}
+
+func TestFlowStart(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git checkout -b "hotfix/crash" "master"`, "", nil)
+	instance := buildFlowCommands(commonDeps{runner: runner})
+
+	branchType, _ := instance.BranchTypeFor("hotfix/")
+	assert.NoError(t, instance.Start(branchType, "crash"))
+	runner.CheckForMissingCalls()
+}
+
+func TestFlowFinish(t *testing.T) {
+	type scenario struct {
+		testName    string
+		branchName  string
+		runner      *oscommands.FakeCmdObjRunner
+		expectedErr string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:   "feature",
+			branchName: "feature/login",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git checkout "develop"`, "", nil).
+				Expect(`git merge --no-edit "feature/login" --no-ff`, "", nil).
+				Expect(`git branch -d "feature/login"`, "", nil),
+		},
+		{
+			testName:   "release is tagged on master",
+			branchName: "release/1.2",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git checkout "master"`, "", nil).
+				Expect(`git merge --no-edit "release/1.2" --no-ff`, "", nil).
+				Expect(`git tag 1.2 master -m "release 1.2"`, "", nil).
+				Expect(`git checkout "develop"`, "", nil).
+				Expect(`git merge --no-edit "release/1.2" --no-ff`, "", nil).
+				Expect(`git branch -d "release/1.2"`, "", nil),
+		},
+		{
+			testName:   "stops at a failed merge",
+			branchName: "hotfix/crash",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git checkout "master"`, "", nil).
+				Expect(`git merge --no-edit "hotfix/crash" --no-ff`, "", errors.New("CONFLICT (content): Merge conflict in file.txt")),
+			expectedErr: "CONFLICT (content): Merge conflict in file.txt",
+		},
+		{
+			testName:    "not a branch of any type",
+			branchName:  "main",
+			runner:      oscommands.NewFakeRunner(t),
+			expectedErr: "This does not seem to be a git flow branch",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildFlowCommands(commonDeps{runner: s.runner})
+
+			err := instance.Finish(s.branchName)
+			if s.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, s.expectedErr)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
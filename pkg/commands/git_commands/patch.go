@@ -2,13 +2,20 @@ package git_commands
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/yaml"
 )
 
+var shaRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
 type PatchCommands struct {
 	*GitCommon
 	rebase *RebaseCommands
@@ -65,7 +72,50 @@ func (self *PatchCommands) DeletePatchesFromCommit(commits []*models.Commit, com
 	return self.rebase.ContinueRebase()
 }
 
-func (self *PatchCommands) MovePatchToSelectedCommit(commits []*models.Commit, sourceCommitIdx int, destinationCommitIdx int) error {
+// GetPatchBaseCommitIdx returns the index of the commit that the patch was diffed
+// against. That's usually the parent of the commit the patch was built from, but in
+// diff mode a patch can be built across several commits. The patch's 'from' is
+// something like '<sha>^' or a branch name, so we resolve it to a sha first
+func (self *PatchCommands) GetPatchBaseCommitIdx(commits []*models.Commit, sourceCommitIdx int) (int, error) {
+	from := self.PatchManager.From
+	if from == models.EmptyTreeCommitHash {
+		// the patch goes all the way back to the root commit
+		return len(commits), nil
+	}
+
+	output, err := self.cmd.New(
+		fmt.Sprintf("git rev-parse --verify %s", self.cmd.Quote(from+"^{commit}")),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return -1, err
+	}
+	sha := strings.TrimSpace(output)
+
+	for idx := sourceCommitIdx + 1; idx < len(commits); idx++ {
+		if commits[idx].Sha == sha {
+			return idx, nil
+		}
+	}
+
+	return -1, errors.New(self.Tr.PatchBaseNotInCommits)
+}
+
+// MovePatchToSelectedCommit takes the patch out of the commits it was built from and
+// amends it into the destination commit, which may be older or newer than the source.
+// sourceCommitIdx is the newest commit the patch was built from and baseCommitIdx is
+// the commit the patch was diffed against, so a patch built from a single commit has
+// a baseCommitIdx of sourceCommitIdx + 1 and a patch built across several commits has
+// a larger one. Patches built across several commits can only be moved into an older
+// commit.
+func (self *PatchCommands) MovePatchToSelectedCommit(commits []*models.Commit, sourceCommitIdx int, baseCommitIdx int, destinationCommitIdx int) error {
+	if destinationCommitIdx > sourceCommitIdx && destinationCommitIdx < baseCommitIdx {
+		return errors.New(self.Tr.CannotMovePatchIntoSourceCommit)
+	}
+
+	if self.rebase.onSuccessfulContinue != nil {
+		return errors.New("You are midway through another rebase operation. Please abort to start again")
+	}
+
 	if sourceCommitIdx < destinationCommitIdx {
 		if err := self.rebase.BeginInteractiveRebaseForCommit(commits, destinationCommitIdx); err != nil {
 			return err
@@ -97,6 +147,13 @@ func (self *PatchCommands) MovePatchToSelectedCommit(commits []*models.Commit, s
 		return errors.New("index outside of range of commits")
 	}
 
+	// the patch is taken out by applying it in reverse to the source commit, which
+	// would leave that commit undoing changes made by the older commits the patch
+	// was built from, so we only support this for single-commit patches
+	if baseCommitIdx != sourceCommitIdx+1 {
+		return errors.New(self.Tr.CannotMoveMultiCommitPatchForward)
+	}
+
 	// we can make this GPG thing possible it just means we need to do this in two parts:
 	// one where we handle the possibility of a credential request, and the other
 	// where we continue the rebase
@@ -114,7 +171,11 @@ func (self *PatchCommands) MovePatchToSelectedCommit(commits []*models.Commit, s
 		}
 	})
 
-	err := self.rebase.PrepareInteractiveRebaseCommand(commits[baseIndex].Sha, todoLines, true).Run()
+	err := self.rebase.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseShaOrRoot:  commits[baseIndex].Sha,
+		todoLines:      todoLines,
+		overrideEditor: true,
+	}).Run()
 	if err != nil {
 		return err
 	}
@@ -132,10 +193,6 @@ func (self *PatchCommands) MovePatchToSelectedCommit(commits []*models.Commit, s
 		return err
 	}
 
-	if self.rebase.onSuccessfulContinue != nil {
-		return errors.New("You are midway through another rebase operation. Please abort to start again")
-	}
-
 	self.rebase.onSuccessfulContinue = func() error {
 		// now we should be up to the destination, so let's apply forward these patches to that.
 		// ideally we would ensure we're on the right commit but I'm not sure if that check is necessary
@@ -254,3 +311,86 @@ func (self *PatchCommands) PullPatchIntoNewCommit(commits []*models.Commit, comm
 	self.PatchManager.Reset()
 	return self.rebase.ContinueRebase()
 }
+
+func (self *PatchCommands) savedPatchesDir() string {
+	return filepath.Join(self.dotGitDir, "lazygit", "patches")
+}
+
+// SaveCustomPatch writes the in-progress custom patch to .git/lazygit so that it can
+// be resumed in a later session. Patches are keyed by the SHA they were built from;
+// patches built from other things (e.g. stash entries) aren't saved because the ref
+// may point somewhere else by the time we come back.
+func (self *PatchCommands) SaveCustomPatch() error {
+	dir := self.savedPatchesDir()
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	if !self.PatchManager.Active() || self.PatchManager.IsEmpty() || !shaRegexp.MatchString(self.PatchManager.To) {
+		return nil
+	}
+
+	content, err := yaml.Marshal(self.PatchManager.Save())
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, self.PatchManager.To+".yml"), content, 0o644)
+}
+
+// RestoreCustomPatch restores the custom patch saved by SaveCustomPatch, if any.
+func (self *PatchCommands) RestoreCustomPatch() error {
+	dir := self.savedPatchesDir()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	// we only ever save one patch at a time
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		saved := &patch.SavedPatch{}
+		if err := yaml.Unmarshal(content, saved); err != nil {
+			return err
+		}
+
+		if err := self.PatchManager.Restore(saved); err != nil {
+			// the commit has most likely been garbage collected so there's nothing to resume
+			_ = os.Remove(path)
+			return err
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+// ExportCustomPatch writes the custom patch to the given path as a regular patch file
+func (self *PatchCommands) ExportCustomPatch(path string) error {
+	return self.os.CreateFileWithContent(path, self.PatchManager.RenderAggregatedPatchColored(true))
+}
+
+// ImportCustomPatch selects the lines in the custom patch that appear in the given
+// patch file. The patch file must have been made against the commit that the custom
+// patch is being built from.
+func (self *PatchCommands) ImportCustomPatch(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return self.PatchManager.AddPatch(string(content))
+}
@@ -0,0 +1,206 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const patchTestDiff = `diff --git a/file1 b/file1
+index 3653080..a6f1e60 100644
+--- a/file1
++++ b/file1
+@@ -1 +1,2 @@
+ one
++two
+`
+
+// buildPatchManager returns a patch manager with the whole of file1 in its patch,
+// which records the flags that each patch is applied with
+func buildPatchManager(from string, appliedFlags *[][]string) *patch.PatchManager {
+	patchManager := patch.NewPatchManager(
+		utils.NewDummyLog(),
+		func(patch string, flags ...string) error {
+			*appliedFlags = append(*appliedFlags, flags)
+			return nil
+		},
+		func(from string, to string, reverse bool, filename string, plain bool) (string, error) {
+			return patchTestDiff, nil
+		},
+	)
+	patchManager.Start(from, "to", false, true)
+	_ = patchManager.AddFileWhole("file1")
+
+	return patchManager
+}
+
+func TestPatchGetPatchBaseCommitIdx(t *testing.T) {
+	commits := []*models.Commit{
+		{Name: "commit", Sha: "123456"},
+		{Name: "commit2", Sha: "abcdef"},
+		{Name: "commit3", Sha: "fedcba"},
+	}
+
+	type scenario struct {
+		testName      string
+		from          string
+		runner        *oscommands.FakeCmdObjRunner
+		expectedIdx   int
+		expectedError bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "parent of the source commit",
+			from:     "123456^",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-parse --verify "123456^^{commit}"`, "abcdef\n", nil),
+			expectedIdx: 1,
+		},
+		{
+			testName: "branch name further down the stack",
+			from:     "feature",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-parse --verify "feature^{commit}"`, "fedcba\n", nil),
+			expectedIdx: 2,
+		},
+		{
+			testName:    "root commit",
+			from:        models.EmptyTreeCommitHash,
+			runner:      oscommands.NewFakeRunner(t),
+			expectedIdx: 3,
+		},
+		{
+			testName: "not in the commits",
+			from:     "other",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-parse --verify "other^{commit}"`, "999999\n", nil),
+			expectedIdx:   -1,
+			expectedError: true,
+		},
+		{
+			testName: "unknown ref",
+			from:     "missing",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-parse --verify "missing^{commit}"`, "", errors.New("fatal: Needed a single revision")),
+			expectedIdx:   -1,
+			expectedError: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			appliedFlags := [][]string{}
+			instance := buildPatchCommands(commonDeps{runner: s.runner}, buildPatchManager(s.from, &appliedFlags))
+
+			idx, err := instance.GetPatchBaseCommitIdx(commits, 0)
+			assert.Equal(t, s.expectedIdx, idx)
+			if s.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestPatchMovePatchToSelectedCommit(t *testing.T) {
+	commits := []*models.Commit{
+		{Name: "commit", Sha: "123456"},
+		{Name: "commit2", Sha: "abcdef"},
+		{Name: "commit3", Sha: "fedcba"},
+		{Name: "commit4", Sha: "aaaaaa"},
+	}
+
+	type scenario struct {
+		testName             string
+		sourceCommitIdx      int
+		baseCommitIdx        int
+		destinationCommitIdx int
+		runner               *oscommands.FakeCmdObjRunner
+		expectedAppliedFlags [][]string
+		expectedError        bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:             "moves the patch into an older commit",
+			sourceCommitIdx:      0,
+			baseCommitIdx:        1,
+			destinationCommitIdx: 2,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rebase --interactive --autostash --keep-empty aaaaaa`, "", nil).
+				Expect(`git commit --amend --no-edit --allow-empty`, "", nil).
+				Expect(`git rebase --continue`, "", nil),
+			expectedAppliedFlags: [][]string{{"index", "3way"}},
+		},
+		{
+			testName:             "moves the patch into a newer commit",
+			sourceCommitIdx:      1,
+			baseCommitIdx:        2,
+			destinationCommitIdx: 0,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rebase --interactive --autostash --keep-empty fedcba`, "", nil).
+				// take the patch out of the source commit
+				Expect(`git commit --amend --no-edit --allow-empty`, "", nil).
+				Expect(`git rebase --continue`, "", nil).
+				// and put it into the destination commit
+				Expect(`git commit --amend --no-edit --allow-empty`, "", nil).
+				Expect(`git rebase --continue`, "", nil),
+			expectedAppliedFlags: [][]string{{"index", "3way", "reverse"}, {"index", "3way"}},
+		},
+		{
+			testName:             "moves a patch built across several commits into an older commit",
+			sourceCommitIdx:      0,
+			baseCommitIdx:        2,
+			destinationCommitIdx: 2,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rebase --interactive --autostash --keep-empty aaaaaa`, "", nil).
+				Expect(`git commit --amend --no-edit --allow-empty`, "", nil).
+				Expect(`git rebase --continue`, "", nil),
+			expectedAppliedFlags: [][]string{{"index", "3way"}},
+		},
+		{
+			testName:             "refuses to move a patch built across several commits into a newer commit",
+			sourceCommitIdx:      1,
+			baseCommitIdx:        3,
+			destinationCommitIdx: 0,
+			runner:               oscommands.NewFakeRunner(t),
+			expectedAppliedFlags: [][]string{},
+			expectedError:        true,
+		},
+		{
+			testName:             "refuses to move a patch into one of the commits it was built from",
+			sourceCommitIdx:      0,
+			baseCommitIdx:        2,
+			destinationCommitIdx: 1,
+			runner:               oscommands.NewFakeRunner(t),
+			expectedAppliedFlags: [][]string{},
+			expectedError:        true,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			appliedFlags := [][]string{}
+			instance := buildPatchCommands(commonDeps{runner: s.runner}, buildPatchManager("from", &appliedFlags))
+
+			err := instance.MovePatchToSelectedCommit(commits, s.sourceCommitIdx, s.baseCommitIdx, s.destinationCommitIdx)
+			if s.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, s.expectedAppliedFlags, appliedFlags)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
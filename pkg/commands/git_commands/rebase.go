@@ -59,7 +59,10 @@ func (self *RebaseCommands) RewordCommitInEditor(commits []*models.Commit, index
 		return nil, err
 	}
 
-	return self.PrepareInteractiveRebaseCommand(sha, todo, false), nil
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseShaOrRoot: sha,
+		todoLines:     todo,
+	}), nil
 }
 
 func (self *RebaseCommands) ResetCommitAuthor(commits []*models.Commit, index int) error {
@@ -105,7 +108,11 @@ func (self *RebaseCommands) MoveCommitDown(commits []*models.Commit, index int)
 
 	todoLines := self.BuildTodoLinesSingleAction(orderedCommits, "pick")
 
-	return self.PrepareInteractiveRebaseCommand(commits[index+2].Sha, todoLines, true).Run()
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseShaOrRoot:  commits[index+2].Sha,
+		todoLines:      todoLines,
+		overrideEditor: true,
+	}).Run()
 }
 
 func (self *RebaseCommands) InteractiveRebase(commits []*models.Commit, index int, action string) error {
@@ -114,14 +121,27 @@ func (self *RebaseCommands) InteractiveRebase(commits []*models.Commit, index in
 		return err
 	}
 
-	return self.PrepareInteractiveRebaseCommand(sha, todo, true).Run()
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseShaOrRoot:  sha,
+		todoLines:      todo,
+		overrideEditor: true,
+	}).Run()
+}
+
+type PrepareInteractiveRebaseCommandOpts struct {
+	baseShaOrRoot  string
+	todoLines      []TodoLine
+	overrideEditor bool
+	// recreate merge commits rather than flattening them. Only useful when we
+	// let git generate the todo list (i.e. when todoLines is empty)
+	rebaseMerges bool
 }
 
 // PrepareInteractiveRebaseCommand returns the cmd for an interactive rebase
 // we tell git to run lazygit to edit the todo list, and we pass the client
 // lazygit a todo string to write to the todo file
-func (self *RebaseCommands) PrepareInteractiveRebaseCommand(baseSha string, todoLines []TodoLine, overrideEditor bool) oscommands.ICmdObj {
-	todo := self.buildTodo(todoLines)
+func (self *RebaseCommands) PrepareInteractiveRebaseCommand(opts PrepareInteractiveRebaseCommandOpts) oscommands.ICmdObj {
+	todo := self.buildTodo(opts.todoLines)
 	ex := oscommands.GetLazygitPath()
 
 	debug := "FALSE"
@@ -129,7 +149,12 @@ func (self *RebaseCommands) PrepareInteractiveRebaseCommand(baseSha string, todo
 		debug = "TRUE"
 	}
 
-	cmdStr := fmt.Sprintf("git rebase --interactive --autostash --keep-empty %s", baseSha)
+	rebaseMergesArg := ""
+	if opts.rebaseMerges {
+		rebaseMergesArg = " --rebase-merges"
+	}
+
+	cmdStr := fmt.Sprintf("git rebase --interactive --autostash --keep-empty%s %s", rebaseMergesArg, opts.baseShaOrRoot)
 	self.Log.WithField("command", cmdStr).Debug("RunCommand")
 
 	cmdObj := self.cmd.New(cmdStr)
@@ -150,7 +175,7 @@ func (self *RebaseCommands) PrepareInteractiveRebaseCommand(baseSha string, todo
 		"GIT_SEQUENCE_EDITOR="+gitSequenceEditor,
 	)
 
-	if overrideEditor {
+	if opts.overrideEditor {
 		cmdObj.AddEnvVars("GIT_EDITOR=" + ex)
 	}
 
@@ -199,55 +224,75 @@ func (self *RebaseCommands) AmendTo(sha string) error {
 	return self.SquashAllAboveFixupCommits(sha)
 }
 
-// EditRebaseTodo sets the action at a given index in the git-rebase-todo file
-func (self *RebaseCommands) EditRebaseTodo(index int, action string) error {
-	fileName := filepath.Join(self.dotGitDir, "rebase-merge/git-rebase-todo")
+func (self *RebaseCommands) todoFilePath() string {
+	return filepath.Join(self.dotGitDir, "rebase-merge/git-rebase-todo")
+}
+
+// modifyRebaseTodo reads the git-rebase-todo file, passes its lines to f along
+// with the line index of the todo at the given index in our commits list, and
+// writes back whatever f returns.
+func (self *RebaseCommands) modifyRebaseTodo(index int, f func(content []string, contentIndex int) []string) error {
+	fileName := self.todoFilePath()
 	bytes, err := ioutil.ReadFile(fileName)
 	if err != nil {
 		return err
 	}
 
 	content := strings.Split(string(bytes), "\n")
-	commitCount := self.getTodoCommitCount(content)
+	todoCount := self.getTodoCount(content)
 
-	// we have the most recent commit at the bottom whereas the todo file has
-	// it at the bottom, so we need to subtract our index from the commit count
-	contentIndex := commitCount - 1 - index
-	splitLine := strings.Split(content[contentIndex], " ")
-	content[contentIndex] = action + " " + strings.Join(splitLine[1:], " ")
-	result := strings.Join(content, "\n")
+	// we have the most recent commit at the top whereas the todo file has
+	// it at the bottom, so we need to subtract our index from the todo count
+	contentIndex := todoCount - 1 - index
+	result := strings.Join(f(content, contentIndex), "\n")
 
 	return ioutil.WriteFile(fileName, []byte(result), 0o644)
 }
 
-func (self *RebaseCommands) getTodoCommitCount(content []string) int {
+// EditRebaseTodo sets the action at a given index in the git-rebase-todo file
+func (self *RebaseCommands) EditRebaseTodo(index int, action string) error {
+	return self.modifyRebaseTodo(index, func(content []string, contentIndex int) []string {
+		splitLine := strings.Split(content[contentIndex], " ")
+		content[contentIndex] = action + " " + strings.Join(splitLine[1:], " ")
+		return content
+	})
+}
+
+// InsertRebaseTodo adds a line (e.g. 'exec make test' or 'break') to the git-rebase-todo
+// file so that it's run straight after the todo at the given index. Passing the index
+// of the commit we're currently stopped at will make it the next thing to run.
+func (self *RebaseCommands) InsertRebaseTodo(index int, line string) error {
+	return self.modifyRebaseTodo(index, func(content []string, contentIndex int) []string {
+		return slices.Insert(content, contentIndex+1, line)
+	})
+}
+
+// DeleteRebaseTodo removes the todo at the given index from the git-rebase-todo file.
+// For commits you'll typically want to 'drop' them instead, so that git knows they were
+// dropped on purpose.
+func (self *RebaseCommands) DeleteRebaseTodo(index int) error {
+	return self.modifyRebaseTodo(index, func(content []string, contentIndex int) []string {
+		return slices.Remove(content, contentIndex)
+	})
+}
+
+func (self *RebaseCommands) getTodoCount(content []string) int {
 	// count lines that are not blank and are not comments
-	commitCount := 0
+	todoCount := 0
 	for _, line := range content {
 		if line != "" && !strings.HasPrefix(line, "#") {
-			commitCount++
+			todoCount++
 		}
 	}
-	return commitCount
+	return todoCount
 }
 
 // MoveTodoDown moves a rebase todo item down by one position
 func (self *RebaseCommands) MoveTodoDown(index int) error {
-	fileName := filepath.Join(self.dotGitDir, "rebase-merge/git-rebase-todo")
-	bytes, err := ioutil.ReadFile(fileName)
-	if err != nil {
-		return err
-	}
-
-	content := strings.Split(string(bytes), "\n")
-	commitCount := self.getTodoCommitCount(content)
-	contentIndex := commitCount - 1 - index
-
-	rearrangedContent := append(content[0:contentIndex-1], content[contentIndex], content[contentIndex-1])
-	rearrangedContent = append(rearrangedContent, content[contentIndex+1:]...)
-	result := strings.Join(rearrangedContent, "\n")
-
-	return ioutil.WriteFile(fileName, []byte(result), 0o644)
+	return self.modifyRebaseTodo(index, func(content []string, contentIndex int) []string {
+		rearrangedContent := append(content[0:contentIndex-1], content[contentIndex], content[contentIndex-1])
+		return append(rearrangedContent, content[contentIndex+1:]...)
+	})
 }
 
 // SquashAllAboveFixupCommits squashes all fixup! commits above the given one
@@ -281,12 +326,31 @@ func (self *RebaseCommands) BeginInteractiveRebaseForCommit(commits []*models.Co
 		return err
 	}
 
-	return self.PrepareInteractiveRebaseCommand(sha, todo, true).Run()
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseShaOrRoot:  sha,
+		todoLines:      todo,
+		overrideEditor: true,
+	}).Run()
+}
+
+// BeginSplitCommit starts an interactive rebase which stops at the given commit
+// and then soft-resets it, so that its changes end up in the index, ready to be
+// committed again in several parts. Once everything has been committed you'll
+// want to call `self.ContinueRebase()`
+func (self *RebaseCommands) BeginSplitCommit(commits []*models.Commit, commitIndex int) error {
+	if err := self.BeginInteractiveRebaseForCommit(commits, commitIndex); err != nil {
+		return err
+	}
+
+	return self.workingTree.ResetSoft("HEAD^")
 }
 
 // RebaseBranch interactive rebases onto a branch
 func (self *RebaseCommands) RebaseBranch(branchName string) error {
-	return self.PrepareInteractiveRebaseCommand(branchName, nil, false).Run()
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseShaOrRoot: branchName,
+		rebaseMerges:  self.UserConfig.Git.Rebasing.RebaseMerges,
+	}).Run()
 }
 
 func (self *RebaseCommands) GenericMergeOrRebaseActionCmdObj(commandType string, command string) oscommands.ICmdObj {
@@ -370,7 +434,10 @@ func (self *RebaseCommands) DiscardOldFileChanges(commits []*models.Commit, comm
 func (self *RebaseCommands) CherryPickCommits(commits []*models.Commit) error {
 	todoLines := self.BuildTodoLinesSingleAction(commits, "pick")
 
-	return self.PrepareInteractiveRebaseCommand("HEAD", todoLines, false).Run()
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseShaOrRoot: "HEAD",
+		todoLines:     todoLines,
+	}).Run()
 }
 
 func (self *RebaseCommands) buildTodo(todoLines []TodoLine) string {
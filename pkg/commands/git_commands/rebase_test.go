@@ -1,6 +1,8 @@
 package git_commands
 
 import (
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -9,16 +11,18 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestRebaseRebaseBranch(t *testing.T) {
 	type scenario struct {
-		testName string
-		arg      string
-		runner   *oscommands.FakeCmdObjRunner
-		test     func(error)
+		testName     string
+		arg          string
+		rebaseMerges bool
+		runner       *oscommands.FakeCmdObjRunner
+		test         func(error)
 	}
 
 	scenarios := []scenario{
@@ -40,12 +44,24 @@ func TestRebaseRebaseBranch(t *testing.T) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			testName:     "rebase keeping merges",
+			arg:          "master",
+			rebaseMerges: true,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rebase --interactive --autostash --keep-empty --rebase-merges master`, "", nil),
+			test: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
 	}
 
 	for _, s := range scenarios {
 		s := s
 		t.Run(s.testName, func(t *testing.T) {
-			instance := buildRebaseCommands(commonDeps{runner: s.runner})
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.Rebasing.RebaseMerges = s.rebaseMerges
+			instance := buildRebaseCommands(commonDeps{runner: s.runner, userConfig: userConfig})
 			s.test(instance.RebaseBranch(s.arg))
 		})
 	}
@@ -150,3 +166,168 @@ func TestRebaseDiscardOldFileChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestRebaseBeginSplitCommit(t *testing.T) {
+	type scenario struct {
+		testName               string
+		gitConfigMockResponses map[string]string
+		commits                []*models.Commit
+		commitIndex            int
+		runner                 *oscommands.FakeCmdObjRunner
+		test                   func(error)
+	}
+
+	scenarios := []scenario{
+		{
+			testName:               "returns error when splitting the first commit",
+			gitConfigMockResponses: nil,
+			commits:                []*models.Commit{{Name: "commit", Sha: "123456"}},
+			commitIndex:            0,
+			runner:                 oscommands.NewFakeRunner(t),
+			test: func(err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			testName:               "returns error when using gpg",
+			gitConfigMockResponses: map[string]string{"commit.gpgsign": "true"},
+			commits: []*models.Commit{
+				{Name: "commit", Sha: "123456"},
+				{Name: "commit2", Sha: "abcdef"},
+			},
+			commitIndex: 0,
+			runner:      oscommands.NewFakeRunner(t),
+			test: func(err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			testName:               "stops at the commit and soft-resets it",
+			gitConfigMockResponses: nil,
+			commits: []*models.Commit{
+				{Name: "commit", Sha: "123456"},
+				{Name: "commit2", Sha: "abcdef"},
+			},
+			commitIndex: 0,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rebase --interactive --autostash --keep-empty abcdef`, "", nil).
+				Expect(`git reset --soft "HEAD^"`, "", nil),
+			test: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName:               "does not reset if the rebase fails",
+			gitConfigMockResponses: nil,
+			commits: []*models.Commit{
+				{Name: "commit", Sha: "123456"},
+				{Name: "commit2", Sha: "abcdef"},
+			},
+			commitIndex: 0,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rebase --interactive --autostash --keep-empty abcdef`, "", errors.New("error")),
+			test: func(err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildRebaseCommands(commonDeps{
+				runner:    s.runner,
+				gitConfig: git_config.NewFakeGitConfig(s.gitConfigMockResponses),
+			})
+
+			s.test(instance.BeginSplitCommit(s.commits, s.commitIndex))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRebaseModifyTodo(t *testing.T) {
+	todo := `pick aaaaaaa first
+exec make test
+pick bbbbbbb second
+
+# Rebase 1234567..bbbbbbb onto 1234567 (3 commands)
+`
+
+	type scenario struct {
+		testName string
+		modify   func(*RebaseCommands) error
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "change the action of a commit",
+			modify:   func(instance *RebaseCommands) error { return instance.EditRebaseTodo(0, "squash") },
+			expected: `pick aaaaaaa first
+exec make test
+squash bbbbbbb second
+
+# Rebase 1234567..bbbbbbb onto 1234567 (3 commands)
+`,
+		},
+		{
+			testName: "insert a break after a todo",
+			modify:   func(instance *RebaseCommands) error { return instance.InsertRebaseTodo(2, "break") },
+			expected: `pick aaaaaaa first
+break
+exec make test
+pick bbbbbbb second
+
+# Rebase 1234567..bbbbbbb onto 1234567 (3 commands)
+`,
+		},
+		{
+			testName: "insert an exec after the current commit",
+			modify:   func(instance *RebaseCommands) error { return instance.InsertRebaseTodo(3, "exec go test ./...") },
+			expected: `exec go test ./...
+pick aaaaaaa first
+exec make test
+pick bbbbbbb second
+
+# Rebase 1234567..bbbbbbb onto 1234567 (3 commands)
+`,
+		},
+		{
+			testName: "delete an exec",
+			modify:   func(instance *RebaseCommands) error { return instance.DeleteRebaseTodo(1) },
+			expected: `pick aaaaaaa first
+pick bbbbbbb second
+
+# Rebase 1234567..bbbbbbb onto 1234567 (3 commands)
+`,
+		},
+		{
+			testName: "move an exec down",
+			modify:   func(instance *RebaseCommands) error { return instance.MoveTodoDown(1) },
+			expected: `exec make test
+pick aaaaaaa first
+pick bbbbbbb second
+
+# Rebase 1234567..bbbbbbb onto 1234567 (3 commands)
+`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			dotGitDir := t.TempDir()
+			todoPath := filepath.Join(dotGitDir, "rebase-merge/git-rebase-todo")
+			assert.NoError(t, os.MkdirAll(filepath.Dir(todoPath), 0o755))
+			assert.NoError(t, os.WriteFile(todoPath, []byte(todo), 0o644))
+
+			instance := buildRebaseCommands(commonDeps{dotGitDir: dotGitDir})
+			assert.NoError(t, s.modify(instance))
+
+			content, err := os.ReadFile(todoPath)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, string(content))
+		})
+	}
+}
@@ -38,6 +38,24 @@ func (self *RemoteCommands) UpdateRemoteUrl(remoteName string, updatedUrl string
 		Run()
 }
 
+// UpdateRemotePushUrl sets the URL used for pushing to the remote, leaving the
+// URL used for fetching as it is
+func (self *RemoteCommands) UpdateRemotePushUrl(remoteName string, updatedUrl string) error {
+	return self.cmd.
+		New(fmt.Sprintf("git remote set-url --push %s %s", self.cmd.Quote(remoteName), self.cmd.Quote(updatedUrl))).
+		Run()
+}
+
+// SetRemoteHeadAutomatically asks the remote which branch its HEAD points to and
+// updates refs/remotes/<remote>/HEAD to match
+func (self *RemoteCommands) SetRemoteHeadAutomatically(remoteName string) error {
+	return self.cmd.
+		New(fmt.Sprintf("git remote set-head %s --auto", self.cmd.Quote(remoteName))).
+		PromptOnCredentialRequest().
+		WithMutex(self.syncMutex).
+		Run()
+}
+
 func (self *RemoteCommands) DeleteRemoteBranch(remoteName string, branchName string) error {
 	command := fmt.Sprintf("git push %s --delete %s", self.cmd.Quote(remoteName), self.cmd.Quote(branchName))
 	return self.cmd.New(command).PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
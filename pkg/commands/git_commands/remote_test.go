@@ -0,0 +1,26 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteUpdateRemotePushUrl(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git remote set-url --push "origin" "git@github.com:me/fork.git"`, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.UpdateRemotePushUrl("origin", "git@github.com:me/fork.git"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteSetRemoteHeadAutomatically(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git remote set-head "origin" --auto`, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetRemoteHeadAutomatically("origin"))
+	runner.CheckForMissingCalls()
+}
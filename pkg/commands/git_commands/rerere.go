@@ -0,0 +1,92 @@
+package git_commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// RerereCommands is for git rerere, which records how we resolve conflicts and
+// then resolves the same conflicts the same way when they come up again
+type RerereCommands struct {
+	*GitCommon
+}
+
+func NewRerereCommands(gitCommon *GitCommon) *RerereCommands {
+	return &RerereCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// IsEnabled tells us whether rerere is on for the repo. Going by git's own rules,
+// if rerere.enabled isn't set then rerere is on if there's an rr-cache directory.
+// We're not going through the cached git config because the user can toggle this.
+func (self *RerereCommands) IsEnabled() bool {
+	output, err := self.cmd.New("git config --get rerere.enabled").DontLog().RunWithOutput()
+	if err == nil && strings.TrimSpace(output) != "" {
+		return strings.TrimSpace(output) == "true"
+	}
+
+	exists, err := self.os.FileExists(filepath.Join(self.dotGitDir, "rr-cache"))
+	return err == nil && exists
+}
+
+func (self *RerereCommands) SetEnabled(enabled bool) error {
+	return self.cmd.New(fmt.Sprintf("git config --local rerere.enabled %t", enabled)).Run()
+}
+
+// Remaining returns the conflicted paths that rerere hasn't resolved, so any
+// other conflicted path was resolved by rerere
+func (self *RerereCommands) Remaining() ([]string, error) {
+	output, err := self.cmd.New("git rerere remaining").DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
+// Apply resolves any conflicts that rerere has a recorded resolution for. This
+// happens anyway when the conflicts come up, but not e.g. if the user has since
+// recreated the conflict markers.
+func (self *RerereCommands) Apply() error {
+	return self.cmd.New("git rerere").Run()
+}
+
+// Forget throws away the recorded resolution for the file's conflicts and brings
+// the conflict markers back so that the user can resolve them again
+func (self *RerereCommands) Forget(path string) error {
+	if err := self.cmd.New(fmt.Sprintf("git rerere forget -- %s", self.cmd.Quote(path))).Run(); err != nil {
+		return err
+	}
+
+	return self.cmd.New(fmt.Sprintf("git checkout -m -- %s", self.cmd.Quote(path))).Run()
+}
+
+// HasRecordedResolution tells us whether rerere has seen the file's current
+// conflict before (i.e. it has a preimage for it) and recorded how it was resolved
+func (self *RerereCommands) HasRecordedResolution(path string) bool {
+	// MERGE_RR maps the ids of the conflicts rerere is tracking to their paths, in
+	// entries like '<id>\t<path>\0'
+	mergeRR, err := os.ReadFile(filepath.Join(self.dotGitDir, "MERGE_RR"))
+	if err != nil {
+		return false
+	}
+
+	for _, entry := range strings.Split(string(mergeRR), "\x00") {
+		id, entryPath, found := strings.Cut(entry, "\t")
+		if !found || entryPath != path {
+			continue
+		}
+
+		rrCacheDir := filepath.Join(self.dotGitDir, "rr-cache", id)
+		hasPreimage, _ := self.os.FileExists(filepath.Join(rrCacheDir, "preimage"))
+		hasPostimage, _ := self.os.FileExists(filepath.Join(rrCacheDir, "postimage"))
+		return hasPreimage && hasPostimage
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerereIsEnabled(t *testing.T) {
+	type scenario struct {
+		testName   string
+		runner     *oscommands.FakeCmdObjRunner
+		hasRRCache bool
+		expected   bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "enabled in config",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git config --get rerere.enabled`, "true\n", nil),
+			expected: true,
+		},
+		{
+			testName: "disabled in config despite rr-cache",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git config --get rerere.enabled`, "false\n", nil),
+			hasRRCache: true,
+			expected:   false,
+		},
+		{
+			testName: "not configured with rr-cache",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git config --get rerere.enabled`, "", errors.New("error")),
+			hasRRCache: true,
+			expected:   true,
+		},
+		{
+			testName: "not configured without rr-cache",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git config --get rerere.enabled`, "", errors.New("error")),
+			expected: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			dotGitDir := t.TempDir()
+			if s.hasRRCache {
+				assert.NoError(t, os.Mkdir(filepath.Join(dotGitDir, "rr-cache"), 0o755))
+			}
+			instance := buildRerereCommands(commonDeps{runner: s.runner, dotGitDir: dotGitDir})
+
+			assert.Equal(t, s.expected, instance.IsEnabled())
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRerereCommands(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git config --local rerere.enabled true`, "", nil).
+		Expect(`git rerere remaining`, "a.txt\nb.txt\n", nil).
+		Expect(`git rerere forget -- "a.txt"`, "", nil).
+		Expect(`git checkout -m -- "a.txt"`, "", nil).
+		Expect(`git rerere`, "", nil)
+	instance := buildRerereCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetEnabled(true))
+	remaining, err := instance.Remaining()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.txt", "b.txt"}, remaining)
+	assert.NoError(t, instance.Forget("a.txt"))
+	assert.NoError(t, instance.Apply())
+	runner.CheckForMissingCalls()
+}
+
+func TestRerereHasRecordedResolution(t *testing.T) {
+	dotGitDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dotGitDir, "MERGE_RR"), []byte("111\tresolved.txt\x00222\tunresolved.txt\x00"), 0o644))
+	for _, path := range []string{"111/preimage", "111/postimage", "222/preimage"} {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dotGitDir, "rr-cache", path)), 0o755))
+		assert.NoError(t, os.WriteFile(filepath.Join(dotGitDir, "rr-cache", path), []byte{}, 0o644))
+	}
+	instance := buildRerereCommands(commonDeps{dotGitDir: dotGitDir})
+
+	assert.True(t, instance.HasRecordedResolution("resolved.txt"))
+	assert.False(t, instance.HasRecordedResolution("unresolved.txt"))
+	assert.False(t, instance.HasRecordedResolution("untracked.txt"))
+}
@@ -27,8 +27,36 @@ func NewSubmoduleCommands(gitCommon *GitCommon) *SubmoduleCommands {
 	}
 }
 
+// GetConfigs returns the repo's submodules, each followed by the submodules nested
+// within it. Nested submodules can only be found once their parent is initialised.
 func (self *SubmoduleCommands) GetConfigs() ([]*models.SubmoduleConfig, error) {
-	file, err := os.Open(".gitmodules")
+	return self.getConfigsRecursively("")
+}
+
+func (self *SubmoduleCommands) getConfigsRecursively(parentPath string) ([]*models.SubmoduleConfig, error) {
+	configs, err := self.getConfigsIn(parentPath)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*models.SubmoduleConfig{}
+	for _, config := range configs {
+		result = append(result, config)
+
+		nestedConfigs, err := self.getConfigsRecursively(config.Path)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nestedConfigs...)
+	}
+
+	return result, nil
+}
+
+// getConfigsIn parses the .gitmodules file of the repo at the given path, which is
+// empty for the top-level repo. The returned paths are relative to the top-level repo.
+func (self *SubmoduleCommands) getConfigsIn(parentPath string) ([]*models.SubmoduleConfig, error) {
+	file, err := os.Open(filepath.Join(parentPath, ".gitmodules"))
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -56,7 +84,7 @@ func (self *SubmoduleCommands) GetConfigs() ([]*models.SubmoduleConfig, error) {
 		line := scanner.Text()
 
 		if name, ok := firstMatch(line, `\[submodule "(.*)"\]`); ok {
-			configs = append(configs, &models.SubmoduleConfig{Name: name})
+			configs = append(configs, &models.SubmoduleConfig{Name: name, ParentPath: parentPath})
 			continue
 		}
 
@@ -64,7 +92,7 @@ func (self *SubmoduleCommands) GetConfigs() ([]*models.SubmoduleConfig, error) {
 			lastConfig := configs[len(configs)-1]
 
 			if path, ok := firstMatch(line, `\s*path\s*=\s*(.*)\s*`); ok {
-				lastConfig.Path = path
+				lastConfig.Path = filepath.ToSlash(filepath.Join(parentPath, path))
 			} else if url, ok := firstMatch(line, `\s*url\s*=\s*(.*)\s*`); ok {
 				lastConfig.Url = url
 			}
@@ -74,6 +102,139 @@ func (self *SubmoduleCommands) GetConfigs() ([]*models.SubmoduleConfig, error) {
 	return configs, nil
 }
 
+// LoadStatuses fills in the checked-out and recorded commits of each submodule, along
+// with its branch and whether it has uncommitted changes
+func (self *SubmoduleCommands) LoadStatuses(configs []*models.SubmoduleConfig) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	output, err := self.cmd.New("git submodule status --recursive").DontLog().RunWithOutput()
+	if err != nil {
+		return err
+	}
+	statuses := parseSubmoduleStatuses(output)
+
+	// the recorded commit only differs from the checked-out one for submodules
+	// marked with a '+', in which case we need to ask for it separately
+	recordedShas := map[string]string{}
+	if strings.Contains(output, "\n+") || strings.HasPrefix(output, "+") {
+		cachedOutput, err := self.cmd.New("git submodule status --cached --recursive").DontLog().RunWithOutput()
+		if err != nil {
+			return err
+		}
+		for path, status := range parseSubmoduleStatuses(cachedOutput) {
+			recordedShas[path] = status.sha
+		}
+	}
+
+	for _, config := range configs {
+		status, ok := statuses[config.Path]
+		if !ok || status.prefix == '-' {
+			config.Initialized = false
+			config.RecordedSha = status.sha
+			continue
+		}
+
+		config.Initialized = true
+		config.Head = status.sha
+		config.RecordedSha = status.sha
+		if recordedSha, ok := recordedShas[config.Path]; ok {
+			config.RecordedSha = recordedSha
+		}
+
+		branchStatusOutput, err := self.cmd.New(
+			"git -C " + self.cmd.Quote(config.Path) + " status --porcelain --branch --untracked-files=normal",
+		).DontLog().RunWithOutput()
+		if err != nil {
+			self.Log.Error(err)
+			continue
+		}
+		config.Branch, config.Dirty = parseSubmoduleBranchStatus(branchStatusOutput)
+	}
+
+	return nil
+}
+
+type submoduleStatus struct {
+	// ' ' if the submodule is on the recorded commit, '+' if it isn't, '-' if it
+	// isn't initialised and 'U' if it has merge conflicts
+	prefix byte
+	sha    string
+}
+
+// parses the output of `git submodule status`, which looks like:
+//
+//	 f3f1b7d5a36c4d1a0e6c2cbd6a1b8f5d1b5e4e1a sub (heads/main)
+//	+8d2c5b4f0e4f0a2b9c3d5e6f7a8b9c0d1e2f3a4b sub/nested (v1.0-1-g8d2c5b4)
+//	-0a1b2c3d4e5f60718293a4b5c6d7e8f9a0b1c2d3 other
+func parseSubmoduleStatuses(output string) map[string]submoduleStatus {
+	statuses := map[string]submoduleStatus{}
+	for _, line := range strings.Split(output, "\n") {
+		if len(line) < 2 {
+			continue
+		}
+
+		fields := strings.Fields(line[1:])
+		if len(fields) < 2 {
+			continue
+		}
+
+		statuses[fields[1]] = submoduleStatus{prefix: line[0], sha: fields[0]}
+	}
+
+	return statuses
+}
+
+// parses the output of `git status --porcelain --branch`, returning the checked-out
+// branch (empty when the HEAD is detached) and whether there are any changes
+func parseSubmoduleBranchStatus(output string) (string, bool) {
+	branch := ""
+	dirty := false
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(line, "## ") {
+			dirty = true
+			continue
+		}
+
+		header := strings.TrimPrefix(line, "## ")
+		header = strings.TrimPrefix(header, "No commits yet on ")
+		if strings.HasPrefix(header, "HEAD (no branch)") {
+			continue
+		}
+		branch, _, _ = strings.Cut(header, "...")
+		branch, _, _ = strings.Cut(branch, " ")
+	}
+
+	return branch, dirty
+}
+
+// nested submodules have to be initialised and updated from within their parent
+func (self *SubmoduleCommands) gitInParent(submodule *models.SubmoduleConfig) string {
+	if submodule.ParentPath == "" {
+		return "git"
+	}
+
+	return "git -C " + self.cmd.Quote(submodule.ParentPath)
+}
+
+// DiffCmdObj shows the commits that the submodule has moved through since the
+// commit recorded for it
+func (self *SubmoduleCommands) DiffCmdObj(submodule *models.SubmoduleConfig) oscommands.ICmdObj {
+	return self.cmd.New(
+		fmt.Sprintf(
+			"%s diff --color=%s --submodule=log -- %s",
+			self.gitInParent(submodule),
+			self.UserConfig.Git.Paging.ColorArg,
+			self.cmd.Quote(submodule.RelativePath()),
+		),
+	).DontLog()
+}
+
 func (self *SubmoduleCommands) Stash(submodule *models.SubmoduleConfig) error {
 	// if the path does not exist then it hasn't yet been initialized so we'll swallow the error
 	// because the intention here is to have no dirty worktree state
@@ -86,7 +247,7 @@ func (self *SubmoduleCommands) Stash(submodule *models.SubmoduleConfig) error {
 }
 
 func (self *SubmoduleCommands) Reset(submodule *models.SubmoduleConfig) error {
-	return self.cmd.New("git submodule update --init --force -- " + self.cmd.Quote(submodule.Path)).Run()
+	return self.cmd.New(self.gitInParent(submodule) + " submodule update --init --force -- " + self.cmd.Quote(submodule.RelativePath())).Run()
 }
 
 func (self *SubmoduleCommands) UpdateAll() error {
@@ -94,16 +255,20 @@ func (self *SubmoduleCommands) UpdateAll() error {
 	return self.cmd.New("git submodule update --force").Run()
 }
 
+// Delete removes the submodule from its parent, which for a nested submodule is
+// the submodule it's nested in rather than the top-level repo
 func (self *SubmoduleCommands) Delete(submodule *models.SubmoduleConfig) error {
 	// based on https://gist.github.com/myusuf3/7f645819ded92bda6677
+	git := self.gitInParent(submodule)
+	path := self.cmd.Quote(submodule.RelativePath())
 
-	if err := self.cmd.New("git submodule deinit --force -- " + self.cmd.Quote(submodule.Path)).Run(); err != nil {
+	if err := self.cmd.New(git + " submodule deinit --force -- " + path).Run(); err != nil {
 		if strings.Contains(err.Error(), "did not match any file(s) known to git") {
-			if err := self.cmd.New("git config --file .gitmodules --remove-section submodule." + self.cmd.Quote(submodule.Name)).Run(); err != nil {
+			if err := self.cmd.New(git + " config --file .gitmodules --remove-section submodule." + self.cmd.Quote(submodule.Name)).Run(); err != nil {
 				return err
 			}
 
-			if err := self.cmd.New("git config --remove-section submodule." + self.cmd.Quote(submodule.Name)).Run(); err != nil {
+			if err := self.cmd.New(git + " config --remove-section submodule." + self.cmd.Quote(submodule.Name)).Run(); err != nil {
 				return err
 			}
 
@@ -113,12 +278,32 @@ func (self *SubmoduleCommands) Delete(submodule *models.SubmoduleConfig) error {
 		}
 	}
 
-	if err := self.cmd.New("git rm --force -r " + submodule.Path).Run(); err != nil {
+	if err := self.cmd.New(git + " rm --force -r " + path).Run(); err != nil {
 		// if the directory isn't there then that's fine
 		self.Log.Error(err)
 	}
 
-	return os.RemoveAll(filepath.Join(self.dotGitDir, "modules", submodule.Path))
+	parentGitDir, err := self.parentGitDir(submodule)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(filepath.Join(parentGitDir, "modules", submodule.RelativePath()))
+}
+
+// parentGitDir returns the git dir of the repo containing the submodule, which is
+// where git keeps the submodule's own git dir (under 'modules/')
+func (self *SubmoduleCommands) parentGitDir(submodule *models.SubmoduleConfig) (string, error) {
+	if !submodule.IsNested() {
+		return self.dotGitDir, nil
+	}
+
+	output, err := self.cmd.New(self.gitInParent(submodule) + " rev-parse --absolute-git-dir").DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(output), nil
 }
 
 func (self *SubmoduleCommands) Add(name string, path string, url string) error {
@@ -133,25 +318,27 @@ func (self *SubmoduleCommands) Add(name string, path string, url string) error {
 		Run()
 }
 
-func (self *SubmoduleCommands) UpdateUrl(name string, path string, newUrl string) error {
+func (self *SubmoduleCommands) UpdateUrl(submodule *models.SubmoduleConfig, newUrl string) error {
+	git := self.gitInParent(submodule)
+
 	// the set-url command is only for later git versions so we're doing it manually here
-	if err := self.cmd.New("git config --file .gitmodules submodule." + self.cmd.Quote(name) + ".url " + self.cmd.Quote(newUrl)).Run(); err != nil {
+	if err := self.cmd.New(git + " config --file .gitmodules submodule." + self.cmd.Quote(submodule.Name) + ".url " + self.cmd.Quote(newUrl)).Run(); err != nil {
 		return err
 	}
 
-	if err := self.cmd.New("git submodule sync -- " + self.cmd.Quote(path)).Run(); err != nil {
+	if err := self.cmd.New(git + " submodule sync -- " + self.cmd.Quote(submodule.RelativePath())).Run(); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (self *SubmoduleCommands) Init(path string) error {
-	return self.cmd.New("git submodule init -- " + self.cmd.Quote(path)).Run()
+func (self *SubmoduleCommands) Init(submodule *models.SubmoduleConfig) error {
+	return self.cmd.New(self.gitInParent(submodule) + " submodule init -- " + self.cmd.Quote(submodule.RelativePath())).Run()
 }
 
-func (self *SubmoduleCommands) Update(path string) error {
-	return self.cmd.New("git submodule update --init -- " + self.cmd.Quote(path)).Run()
+func (self *SubmoduleCommands) Update(submodule *models.SubmoduleConfig) error {
+	return self.cmd.New(self.gitInParent(submodule) + " submodule update --init -- " + self.cmd.Quote(submodule.RelativePath())).Run()
 }
 
 func (self *SubmoduleCommands) BulkInitCmdObj() oscommands.ICmdObj {
@@ -162,6 +349,14 @@ func (self *SubmoduleCommands) BulkUpdateCmdObj() oscommands.ICmdObj {
 	return self.cmd.New("git submodule update")
 }
 
+func (self *SubmoduleCommands) BulkUpdateRecursivelyCmdObj() oscommands.ICmdObj {
+	return self.cmd.New("git submodule update --init --recursive")
+}
+
+func (self *SubmoduleCommands) BulkSyncRecursivelyCmdObj() oscommands.ICmdObj {
+	return self.cmd.New("git submodule sync --recursive")
+}
+
 func (self *SubmoduleCommands) ForceBulkUpdateCmdObj() oscommands.ICmdObj {
 	return self.cmd.New("git submodule update --force")
 }
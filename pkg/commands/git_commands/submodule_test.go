@@ -0,0 +1,117 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmoduleLoadStatuses(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git submodule status --recursive`, " 1111111111111111111111111111111111111111 sub (heads/main)\n+2222222222222222222222222222222222222222 sub/nested (v1.0-1-g2222222)\n-3333333333333333333333333333333333333333 other\n", nil).
+		Expect(`git submodule status --cached --recursive`, " 1111111111111111111111111111111111111111 sub (heads/main)\n 4444444444444444444444444444444444444444 sub/nested (v1.0)\n-3333333333333333333333333333333333333333 other\n", nil).
+		Expect(`git -C "sub" status --porcelain --branch --untracked-files=normal`, "## main...origin/main [ahead 1]\n", nil).
+		Expect(`git -C "sub/nested" status --porcelain --branch --untracked-files=normal`, "## HEAD (no branch)\n M file.txt\n", nil)
+	instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+	configs := []*models.SubmoduleConfig{
+		{Name: "sub", Path: "sub"},
+		{Name: "nested", Path: "sub/nested", ParentPath: "sub"},
+		{Name: "other", Path: "other"},
+	}
+	assert.NoError(t, instance.LoadStatuses(configs))
+	runner.CheckForMissingCalls()
+
+	assert.Equal(t, &models.SubmoduleConfig{
+		Name:        "sub",
+		Path:        "sub",
+		Head:        "1111111111111111111111111111111111111111",
+		RecordedSha: "1111111111111111111111111111111111111111",
+		Branch:      "main",
+		Initialized: true,
+	}, configs[0])
+	assert.Equal(t, &models.SubmoduleConfig{
+		Name:        "nested",
+		Path:        "sub/nested",
+		ParentPath:  "sub",
+		Head:        "2222222222222222222222222222222222222222",
+		RecordedSha: "4444444444444444444444444444444444444444",
+		Initialized: true,
+		Dirty:       true,
+	}, configs[1])
+	assert.Equal(t, &models.SubmoduleConfig{
+		Name:        "other",
+		Path:        "other",
+		RecordedSha: "3333333333333333333333333333333333333333",
+	}, configs[2])
+}
+
+func TestParseSubmoduleBranchStatus(t *testing.T) {
+	type scenario struct {
+		testName       string
+		output         string
+		expectedBranch string
+		expectedDirty  bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:       "clean branch with upstream",
+			output:         "## main...origin/main [behind 2]\n",
+			expectedBranch: "main",
+			expectedDirty:  false,
+		},
+		{
+			testName:       "branch without upstream with untracked file",
+			output:         "## feature\n?? new.txt\n",
+			expectedBranch: "feature",
+			expectedDirty:  true,
+		},
+		{
+			testName:       "no commits yet",
+			output:         "## No commits yet on main\n",
+			expectedBranch: "main",
+			expectedDirty:  false,
+		},
+		{
+			testName:       "detached head",
+			output:         "## HEAD (no branch)\n",
+			expectedBranch: "",
+			expectedDirty:  false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			branch, dirty := parseSubmoduleBranchStatus(s.output)
+			assert.Equal(t, s.expectedBranch, branch)
+			assert.Equal(t, s.expectedDirty, dirty)
+		})
+	}
+}
+
+func TestSubmoduleNestedCommands(t *testing.T) {
+	nested := &models.SubmoduleConfig{Name: "nested", Path: "sub/nested", ParentPath: "sub"}
+
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git -C "sub" submodule init -- "nested"`, "", nil).
+		Expect(`git -C "sub" submodule update --init -- "nested"`, "", nil).
+		Expect(`git submodule update --init -- "sub"`, "", nil).
+		Expect(`git -C "sub" config --file .gitmodules submodule."nested".url "https://example.com/nested.git"`, "", nil).
+		Expect(`git -C "sub" submodule sync -- "nested"`, "", nil).
+		Expect(`git -C "sub" submodule deinit --force -- "nested"`, "", nil).
+		Expect(`git -C "sub" rm --force -r "nested"`, "", nil).
+		Expect(`git -C "sub" rev-parse --absolute-git-dir`, t.TempDir()+"\n", nil)
+	instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Init(nested))
+	assert.NoError(t, instance.Update(nested))
+	assert.NoError(t, instance.Update(&models.SubmoduleConfig{Name: "sub", Path: "sub"}))
+	assert.NoError(t, instance.UpdateUrl(nested, "https://example.com/nested.git"))
+	assert.NoError(t, instance.Delete(nested))
+	assert.Equal(t, `git -C "sub" diff --color=always --submodule=log -- "nested"`, instance.DiffCmdObj(nested).ToString())
+	runner.CheckForMissingCalls()
+}
@@ -2,9 +2,11 @@ package git_commands
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type SyncCommands struct {
@@ -23,28 +25,61 @@ type PushOpts struct {
 	UpstreamRemote string
 	UpstreamBranch string
 	SetUpstream    bool
+	// an arbitrary refspec e.g. 'HEAD:refs/for/main', pushed in place of UpstreamBranch
+	Refspec string
+	// when force pushing, only overwrite the remote ref if it still points at the given sha
+	ForceWithLeaseRef string
+	ForceWithLeaseSha string
+	Atomic            bool
+	NoVerify          bool
+	TagsOnly          bool
+	PushOptions       []string
 }
 
 func (self *SyncCommands) PushCmdObj(opts PushOpts) (oscommands.ICmdObj, error) {
 	cmdStr := "git push"
 
 	if opts.Force {
-		cmdStr += " --force-with-lease"
+		if opts.ForceWithLeaseRef != "" {
+			cmdStr += " " + self.cmd.Quote(fmt.Sprintf("--force-with-lease=%s:%s", opts.ForceWithLeaseRef, opts.ForceWithLeaseSha))
+		} else {
+			cmdStr += " --force-with-lease"
+		}
 	}
 
 	if opts.SetUpstream {
 		cmdStr += " --set-upstream"
 	}
 
+	if opts.Atomic {
+		cmdStr += " --atomic"
+	}
+
+	if opts.NoVerify {
+		cmdStr += " --no-verify"
+	}
+
+	if opts.TagsOnly {
+		cmdStr += " --tags"
+	}
+
+	for _, pushOption := range opts.PushOptions {
+		cmdStr += " -o " + self.cmd.Quote(pushOption)
+	}
+
 	if opts.UpstreamRemote != "" {
 		cmdStr += " " + self.cmd.Quote(opts.UpstreamRemote)
 	}
 
-	if opts.UpstreamBranch != "" {
+	if opts.UpstreamBranch != "" || opts.Refspec != "" {
 		if opts.UpstreamRemote == "" {
 			return nil, errors.New(self.Tr.MustSpecifyOriginError)
 		}
-		cmdStr += " " + self.cmd.Quote(opts.UpstreamBranch)
+		if opts.Refspec != "" {
+			cmdStr += " " + self.cmd.Quote(opts.Refspec)
+		} else {
+			cmdStr += " " + self.cmd.Quote(opts.UpstreamBranch)
+		}
 	}
 
 	cmdObj := self.cmd.New(cmdStr).PromptOnCredentialRequest().WithMutex(self.syncMutex)
@@ -90,15 +125,29 @@ type PullOptions struct {
 	RemoteName      string
 	BranchName      string
 	FastForwardOnly bool
+	// if neither Rebase nor NoRebase is set we go with the pull.rebase config
+	Rebase    bool
+	NoRebase  bool
+	AutoStash bool
 }
 
-func (self *SyncCommands) Pull(opts PullOptions) error {
+func (self *SyncCommands) PullCmdObj(opts PullOptions) oscommands.ICmdObj {
 	cmdStr := "git pull --no-edit"
 
 	if opts.FastForwardOnly {
 		cmdStr += " --ff-only"
 	}
 
+	if opts.Rebase {
+		cmdStr += " --rebase"
+	} else if opts.NoRebase {
+		cmdStr += " --no-rebase"
+	}
+
+	if opts.AutoStash {
+		cmdStr += " --autostash"
+	}
+
 	if opts.RemoteName != "" {
 		cmdStr = fmt.Sprintf("%s %s", cmdStr, self.cmd.Quote(opts.RemoteName))
 	}
@@ -108,7 +157,11 @@ func (self *SyncCommands) Pull(opts PullOptions) error {
 
 	// setting GIT_SEQUENCE_EDITOR to ':' as a way of skipping it, in case the user
 	// has 'pull.rebase = interactive' configured.
-	return self.cmd.New(cmdStr).AddEnvVars("GIT_SEQUENCE_EDITOR=:").PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
+	return self.cmd.New(cmdStr).AddEnvVars("GIT_SEQUENCE_EDITOR=:").PromptOnCredentialRequest().WithMutex(self.syncMutex)
+}
+
+func (self *SyncCommands) Pull(opts PullOptions) error {
+	return self.PullCmdObj(opts).Run()
 }
 
 func (self *SyncCommands) FastForward(branchName string, remoteName string, remoteBranchName string) error {
@@ -116,7 +169,66 @@ func (self *SyncCommands) FastForward(branchName string, remoteName string, remo
 	return self.cmd.New(cmdStr).PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
 }
 
+// RemoteBranchSha returns the sha that the remote branch pointed to as of the last fetch
+func (self *SyncCommands) RemoteBranchSha(remoteName string, branchName string) (string, error) {
+	output, err := self.cmd.New(
+		fmt.Sprintf("git rev-parse --verify %s", self.cmd.Quote(fmt.Sprintf("refs/remotes/%s/%s", remoteName, branchName))),
+	).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+// RemoteBranchShas returns the sha of each of the remote's branches as of the last
+// fetch, keyed by branch name (without the remote prefix)
+func (self *SyncCommands) RemoteBranchShas(remoteName string) (map[string]string, error) {
+	prefix := fmt.Sprintf("refs/remotes/%s/", remoteName)
+	output, err := self.cmd.New(
+		fmt.Sprintf(`git for-each-ref --format="%%(refname)%%00%%(objectname)" %s`, self.cmd.Quote(prefix)),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	shas := map[string]string{}
+	for _, line := range utils.SplitLines(output) {
+		refName, sha, ok := strings.Cut(line, "\x00")
+		if !ok {
+			continue
+		}
+		shas[strings.TrimPrefix(refName, prefix)] = sha
+	}
+
+	return shas, nil
+}
+
+// IsAuthError tells us whether a background fetch failed because the remote
+// wanted credentials, as opposed to e.g. the network being down, in which case
+// it's worth trying again later
+func IsAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, message := range []string{
+		"Authentication failed",
+		"could not read Username",
+		"could not read Password",
+		"Permission denied",
+	} {
+		if strings.Contains(err.Error(), message) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (self *SyncCommands) FetchRemote(remoteName string) error {
 	cmdStr := fmt.Sprintf("git fetch %s", self.cmd.Quote(remoteName))
 	return self.cmd.New(cmdStr).PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
 }
+
+// FetchAllRemotes fetches every remote, pruning remote-tracking branches which no
+// longer exist on their remote and fetching all tags
+func (self *SyncCommands) FetchAllRemotes() error {
+	return self.cmd.New("git fetch --all --prune --tags").PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
+}
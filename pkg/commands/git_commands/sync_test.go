@@ -3,6 +3,8 @@ package git_commands
 import (
 	"testing"
 
+	"github.com/go-errors/errors"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/stretchr/testify/assert"
 )
@@ -69,6 +71,62 @@ func TestSyncPush(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			testName: "Push with lease on an expected sha",
+			opts: PushOpts{
+				Force:             true,
+				ForceWithLeaseRef: "master",
+				ForceWithLeaseSha: "abc123",
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.ToString(), `git push "--force-with-lease=master:abc123"`)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push a refspec",
+			opts: PushOpts{
+				UpstreamRemote: "origin",
+				Refspec:        "HEAD:refs/for/main",
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.ToString(), `git push "origin" "HEAD:refs/for/main"`)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push with atomic, no-verify and push options",
+			opts: PushOpts{
+				Atomic:      true,
+				NoVerify:    true,
+				PushOptions: []string{"ci.skip", "topic=my topic"},
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.ToString(), `git push --atomic --no-verify -o "ci.skip" -o "topic=my topic"`)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push tags only",
+			opts: PushOpts{
+				UpstreamRemote: "origin",
+				TagsOnly:       true,
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.ToString(), `git push --tags "origin"`)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push refspec but no origin",
+			opts: PushOpts{
+				Refspec: "HEAD:refs/for/main",
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Error(t, err)
+				assert.EqualValues(t, "Must specify a remote if specifying a branch", err.Error())
+			},
+		},
 		{
 			testName: "Push with remote branch but no origin",
 			opts: PushOpts{
@@ -92,3 +150,80 @@ func TestSyncPush(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncRemoteBranchSha(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git rev-parse --verify "refs/remotes/origin/master"`, "abc123\n", nil)
+	instance := buildSyncCommands(commonDeps{runner: runner})
+
+	sha, err := instance.RemoteBranchSha("origin", "master")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", sha)
+	runner.CheckForMissingCalls()
+}
+
+func TestSyncRemoteBranchShas(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git for-each-ref --format="%(refname)%00%(objectname)" "refs/remotes/origin/"`, "refs/remotes/origin/HEAD\x00abc123\nrefs/remotes/origin/feature/x\x00def456\n", nil)
+	instance := buildSyncCommands(commonDeps{runner: runner})
+
+	shas, err := instance.RemoteBranchShas("origin")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"HEAD": "abc123", "feature/x": "def456"}, shas)
+	runner.CheckForMissingCalls()
+}
+
+func TestIsAuthError(t *testing.T) {
+	assert.False(t, IsAuthError(nil))
+	assert.False(t, IsAuthError(errors.New("fatal: unable to access 'https://github.com/x/y.git/': Could not resolve host: github.com")))
+	assert.True(t, IsAuthError(errors.New("fatal: Authentication failed for 'https://github.com/x/y.git/'")))
+	assert.True(t, IsAuthError(errors.New("git@github.com: Permission denied (publickey).")))
+}
+
+func TestSyncPullCmdObj(t *testing.T) {
+	type scenario struct {
+		testName string
+		opts     PullOptions
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "Default pull",
+			opts:     PullOptions{},
+			expected: "git pull --no-edit",
+		},
+		{
+			testName: "Rebase with autostash",
+			opts:     PullOptions{Rebase: true, AutoStash: true},
+			expected: "git pull --no-edit --rebase --autostash",
+		},
+		{
+			testName: "Merge",
+			opts:     PullOptions{NoRebase: true},
+			expected: "git pull --no-edit --no-rebase",
+		},
+		{
+			testName: "Fast-forward only from a given remote branch",
+			opts:     PullOptions{FastForwardOnly: true, RemoteName: "origin", BranchName: "master"},
+			expected: `git pull --no-edit --ff-only "origin" "master"`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSyncCommands(commonDeps{})
+			assert.Equal(t, s.expected, instance.PullCmdObj(s.opts).ToString())
+		})
+	}
+}
+
+func TestSyncFetchAllRemotes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git fetch --all --prune --tags`, "", nil)
+	instance := buildSyncCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.FetchAllRemotes())
+	runner.CheckForMissingCalls()
+}
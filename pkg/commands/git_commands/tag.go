@@ -2,6 +2,8 @@ package git_commands
 
 import (
 	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 )
 
 type TagCommands struct {
@@ -29,3 +31,21 @@ func (self *TagCommands) Delete(tagName string) error {
 func (self *TagCommands) Push(remoteName string, tagName string) error {
 	return self.cmd.New(fmt.Sprintf("git push %s %s", self.cmd.Quote(remoteName), self.cmd.Quote(tagName))).PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
 }
+
+func (self *TagCommands) DeleteRemote(remoteName string, tagName string) error {
+	command := fmt.Sprintf("git push %s --delete %s", self.cmd.Quote(remoteName), self.cmd.Quote("refs/tags/"+tagName))
+	return self.cmd.New(command).PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
+}
+
+// ShowCmdObj returns a command which prints the tag's annotation (if it's an
+// annotated tag) followed by the commit it points to
+func (self *TagCommands) ShowCmdObj(tagName string) oscommands.ICmdObj {
+	cmdStr := fmt.Sprintf("git show --color=%s --stat %s --", self.UserConfig.Git.Paging.ColorArg, self.cmd.Quote("refs/tags/"+tagName))
+	return self.cmd.New(cmdStr).DontLog()
+}
+
+// VerifyCmdObj returns a command which prints the result of verifying the
+// tag's GPG or SSH signature. Only annotated tags can be signed
+func (self *TagCommands) VerifyCmdObj(tagName string) oscommands.ICmdObj {
+	return self.cmd.New(fmt.Sprintf("git verify-tag %s", self.cmd.Quote(tagName))).DontLog()
+}
@@ -0,0 +1,23 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagDeleteRemote(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git push "origin" --delete "refs/tags/v1.0"`, "", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.DeleteRemote("origin", "v1.0"))
+	runner.CheckForMissingCalls()
+}
+
+func TestTagShowCmdObj(t *testing.T) {
+	instance := buildTagCommands(commonDeps{})
+
+	assert.Equal(t, `git show --color=always --stat "refs/tags/v1.0" --`, instance.ShowCmdObj("v1.0").ToString())
+}
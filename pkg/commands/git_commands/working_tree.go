@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -53,6 +54,35 @@ func (self *WorkingTreeCommands) StageFiles(paths []string) error {
 	return self.cmd.New(fmt.Sprintf("git add -- %s", strings.Join(quotedPaths, " "))).Run()
 }
 
+// ResolveConflictWithOurs resolves a conflicted file by taking our version of it
+// as a whole
+func (self *WorkingTreeCommands) ResolveConflictWithOurs(path string) error {
+	return self.resolveConflictWithSide(path, "--ours")
+}
+
+// ResolveConflictWithTheirs resolves a conflicted file by taking their version of
+// it as a whole
+func (self *WorkingTreeCommands) ResolveConflictWithTheirs(path string) error {
+	return self.resolveConflictWithSide(path, "--theirs")
+}
+
+func (self *WorkingTreeCommands) resolveConflictWithSide(path string, side string) error {
+	if err := self.cmd.New(fmt.Sprintf("git checkout %s -- %s", side, self.cmd.Quote(path))).Run(); err != nil {
+		return err
+	}
+
+	return self.StageFile(path)
+}
+
+// RemoveConflictedFiles resolves conflicts by deleting the files, e.g. to accept
+// a deletion on one side, or to drop one of the names a file was renamed to
+func (self *WorkingTreeCommands) RemoveConflictedFiles(paths []string) error {
+	quotedPaths := slices.Map(paths, func(path string) string {
+		return self.cmd.Quote(path)
+	})
+	return self.cmd.New(fmt.Sprintf("git rm --quiet -- %s", strings.Join(quotedPaths, " "))).Run()
+}
+
 // StageAll stages all files
 func (self *WorkingTreeCommands) StageAll() error {
 	return self.cmd.New("git add -A").Run()
@@ -375,3 +405,170 @@ func pad(str string) string {
 
 	return " " + str
 }
+
+// the index stages of a conflicted file
+const (
+	BASE_STAGE   = 1
+	OURS_STAGE   = 2
+	THEIRS_STAGE = 3
+)
+
+// ShowStage returns the content of a conflicted file at the given index stage. A
+// stage can be missing, e.g. there's no base when both sides added the file, in
+// which case we return an empty string.
+func (self *WorkingTreeCommands) ShowStage(path string, stage int) string {
+	output, err := self.cmd.New(
+		fmt.Sprintf("git show %s", self.cmd.Quote(fmt.Sprintf(":%d:%s", stage, path))),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+
+	return output
+}
+
+// RenameConflict is a file that was renamed differently on each side, which leaves
+// its original path deleted by both (DD) and each new path added by one side (AU
+// and UA).
+type RenameConflict struct {
+	// empty if we can't tell which path the file was renamed from
+	OriginalPath string
+	OurPath      string
+	TheirPath    string
+}
+
+func (self RenameConflict) Paths() []string {
+	if self.OriginalPath == "" {
+		return []string{self.OurPath, self.TheirPath}
+	}
+
+	return []string{self.OriginalPath, self.OurPath, self.TheirPath}
+}
+
+// RenameConflicts pairs up the paths of each rename/rename conflict using the
+// index stages from `git ls-files -u`. Git gives both new paths the same blob, at
+// stage 2 for our path and stage 3 for theirs. The original path only has a base
+// stage, which has the same blob as the new paths unless the file was also
+// modified, in which case we can only match it up if it's the only one left over.
+func (self *WorkingTreeCommands) RenameConflicts() ([]RenameConflict, error) {
+	output, err := self.cmd.New("git ls-files -u -z").DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return renameConflictsFromStages(output), nil
+}
+
+func renameConflictsFromStages(output string) []RenameConflict {
+	// blob sha of each stage of each path, in the order the paths are listed
+	paths := []string{}
+	stageShas := map[string]map[int]string{}
+	for _, entry := range strings.Split(output, "\x00") {
+		// <mode> <sha> <stage>\t<path>
+		info, path, found := strings.Cut(entry, "\t")
+		fields := strings.Fields(info)
+		if !found || len(fields) != 3 {
+			continue
+		}
+		stage, err := strconv.Atoi(fields[2])
+		if err != nil {
+			continue
+		}
+
+		if _, ok := stageShas[path]; !ok {
+			paths = append(paths, path)
+			stageShas[path] = map[int]string{}
+		}
+		stageShas[path][stage] = fields[1]
+	}
+
+	// the sha of the stage that's the only one the path has, if any
+	onlyStage := func(path string, stage int) (string, bool) {
+		shas := stageShas[path]
+		sha, ok := shas[stage]
+		return sha, ok && len(shas) == 1
+	}
+
+	conflicts := []RenameConflict{}
+	shas := []string{}
+	pairedPaths := map[string]bool{}
+	for _, ourPath := range paths {
+		ourSha, ok := onlyStage(ourPath, OURS_STAGE)
+		if !ok {
+			continue
+		}
+
+		for _, theirPath := range paths {
+			if theirSha, ok := onlyStage(theirPath, THEIRS_STAGE); ok && theirSha == ourSha && !pairedPaths[theirPath] {
+				pairedPaths[theirPath] = true
+				conflicts = append(conflicts, RenameConflict{OurPath: ourPath, TheirPath: theirPath})
+				shas = append(shas, ourSha)
+				break
+			}
+		}
+	}
+
+	unmatchedDeletedPaths := []string{}
+	for _, path := range paths {
+		baseSha, ok := onlyStage(path, BASE_STAGE)
+		if !ok {
+			continue
+		}
+
+		matched := false
+		for i := range conflicts {
+			if conflicts[i].OriginalPath == "" && shas[i] == baseSha {
+				conflicts[i].OriginalPath = path
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			unmatchedDeletedPaths = append(unmatchedDeletedPaths, path)
+		}
+	}
+
+	unmatchedConflictIdxs := []int{}
+	for i, conflict := range conflicts {
+		if conflict.OriginalPath == "" {
+			unmatchedConflictIdxs = append(unmatchedConflictIdxs, i)
+		}
+	}
+	if len(unmatchedDeletedPaths) == 1 && len(unmatchedConflictIdxs) == 1 {
+		conflicts[unmatchedConflictIdxs[0]].OriginalPath = unmatchedDeletedPaths[0]
+	}
+
+	return conflicts
+}
+
+// ThreeWayMerge redoes the merge of a conflicted file from its base, ours and
+// theirs index stages, returning the result with diff3 style conflict markers.
+// That way each conflict has a section for the base regardless of the user's
+// merge.conflictStyle.
+func (self *WorkingTreeCommands) ThreeWayMerge(path string) (string, error) {
+	dir := filepath.Join(self.os.GetTempDir(), utils.GetCurrentRepoName(), "three-way-merge")
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	stagePaths := []string{}
+	for _, stage := range []struct {
+		name  string
+		stage int
+	}{{"ours", OURS_STAGE}, {"base", BASE_STAGE}, {"theirs", THEIRS_STAGE}} {
+		stagePath := filepath.Join(dir, stage.name)
+		if err := self.os.CreateFileWithContent(stagePath, self.ShowStage(path, stage.stage)); err != nil {
+			return "", err
+		}
+		stagePaths = append(stagePaths, self.cmd.Quote(stagePath))
+	}
+
+	output, err := self.cmd.New(
+		fmt.Sprintf("git merge-file -p --diff3 -L ours -L base -L theirs %s", strings.Join(stagePaths, " ")),
+	).DontLog().RunWithOutput()
+	// merge-file exits with the number of conflicts, so we only have a real error
+	// if we didn't get any conflicts back
+	if err != nil && !strings.Contains(output, "<<<<<<< ") {
+		return "", err
+	}
+
+	return output, nil
+}
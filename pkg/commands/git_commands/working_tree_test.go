@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/go-errors/errors"
@@ -33,6 +34,22 @@ func TestWorkingTreeStageFiles(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestWorkingTreeResolveConflicts(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git checkout --ours -- "test.txt"`, "", nil).
+		Expect(`git add -- "test.txt"`, "", nil).
+		Expect(`git checkout --theirs -- "image.png"`, "", nil).
+		Expect(`git add -- "image.png"`, "", nil).
+		Expect(`git rm --quiet -- "old.txt" "new.txt"`, "", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.ResolveConflictWithOurs("test.txt"))
+	assert.NoError(t, instance.ResolveConflictWithTheirs("image.png"))
+	assert.NoError(t, instance.RemoveConflictedFiles([]string{"old.txt", "new.txt"}))
+	runner.CheckForMissingCalls()
+}
+
 func TestWorkingTreeUnstageFile(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -584,3 +601,95 @@ func TestWorkingTreeResetHard(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkingTreeThreeWayMerge(t *testing.T) {
+	conflicted := "<<<<<<< ours\nours\n||||||| base\nbase\n=======\ntheirs\n>>>>>>> theirs\n"
+
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git show ":2:file.txt"`, "ours\n", nil).
+		Expect(`git show ":1:file.txt"`, "", errors.New("fatal: path 'file.txt' is in the index, but not at stage 1")).
+		Expect(`git show ":3:file.txt"`, "theirs\n", nil).
+		ExpectFunc(func(cmdObj oscommands.ICmdObj) (string, error) {
+			args := cmdObj.GetCmd().Args
+			assert.Equal(t, []string{"git", "merge-file", "-p", "--diff3", "-L", "ours", "-L", "base", "-L", "theirs"}, args[:10])
+
+			contents := []string{}
+			for _, path := range args[10:] {
+				content, err := ioutil.ReadFile(path)
+				assert.NoError(t, err)
+				contents = append(contents, string(content))
+			}
+			assert.Equal(t, []string{"ours\n", "", "theirs\n"}, contents)
+
+			return conflicted, errors.New(conflicted)
+		})
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	output, err := instance.ThreeWayMerge("file.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, conflicted, output)
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeRenameConflicts(t *testing.T) {
+	type scenario struct {
+		testName string
+		output   string
+		expected []RenameConflict
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "no rename conflicts",
+			output: strings.Join([]string{
+				"100644 aaaaaaa 1\tfile.txt",
+				"100644 bbbbbbb 2\tfile.txt",
+				"100644 ccccccc 3\tfile.txt",
+				"100644 ddddddd 2\tadded-by-us.txt",
+			}, "\x00") + "\x00",
+			expected: []RenameConflict{},
+		},
+		{
+			testName: "unmodified and modified renames",
+			output: strings.Join([]string{
+				"100644 aaaaaaa 2\ta",
+				"100644 aaaaaaa 3\tb",
+				"100644 bbbbbbb 1\tmodified",
+				"100644 aaaaaaa 1\torig",
+				"100644 ccccccc 2\tx",
+				"100644 ccccccc 3\ty",
+			}, "\x00") + "\x00",
+			expected: []RenameConflict{
+				{OriginalPath: "orig", OurPath: "a", TheirPath: "b"},
+				{OriginalPath: "modified", OurPath: "x", TheirPath: "y"},
+			},
+		},
+		{
+			testName: "ambiguous original path",
+			output: strings.Join([]string{
+				"100644 aaaaaaa 2\ta",
+				"100644 aaaaaaa 3\tb",
+				"100644 bbbbbbb 1\tdeleted-by-both",
+				"100644 ccccccc 1\torig",
+				"100644 ddddddd 3\tadded-by-them",
+			}, "\x00") + "\x00",
+			expected: []RenameConflict{
+				{OurPath: "a", TheirPath: "b"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				Expect(`git ls-files -u -z`, s.output, nil)
+			instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+			conflicts, err := instance.RenameConflicts()
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, conflicts)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
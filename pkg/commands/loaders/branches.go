@@ -2,6 +2,7 @@ package loaders
 
 import (
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/jesseduffield/generics/set"
@@ -32,6 +33,8 @@ type BranchLoader struct {
 	*common.Common
 	getRawBranches       func() (string, error)
 	getCurrentBranchName func() (string, string, error)
+	getBaseBranch        func() string
+	getCommitDifferences func(from, to string) (string, string)
 	config               BranchLoaderConfigCommands
 }
 
@@ -39,12 +42,16 @@ func NewBranchLoader(
 	cmn *common.Common,
 	getRawBranches func() (string, error),
 	getCurrentBranchName func() (string, string, error),
+	getBaseBranch func() string,
+	getCommitDifferences func(from, to string) (string, string),
 	config BranchLoaderConfigCommands,
 ) *BranchLoader {
 	return &BranchLoader{
 		Common:               cmn,
 		getRawBranches:       getRawBranches,
 		getCurrentBranchName: getCurrentBranchName,
+		getBaseBranch:        getBaseBranch,
+		getCommitDifferences: getCommitDifferences,
 		config:               config,
 	}
 }
@@ -53,6 +60,13 @@ func NewBranchLoader(
 func (self *BranchLoader) Load(reflogCommits []*models.Commit) ([]*models.Branch, error) {
 	branches := self.obtainBranches()
 
+	// git gives us the branches sorted by committer date, so we hold onto that
+	// order in case we want to sort by it
+	committerDateOrder := make(map[string]int, len(branches))
+	for i, branch := range branches {
+		committerDateOrder[branch.Name] = i
+	}
+
 	reflogBranches := self.obtainReflogBranches(reflogCommits)
 
 	// loop through reflog branches. If there is a match, merge them, then remove it from the branches and keep it in the reflog branches
@@ -91,6 +105,11 @@ outer:
 		branches = slices.Prepend(branches, &models.Branch{Name: currentBranchName, DisplayName: currentBranchDisplayName, Head: true, Recency: "  *"})
 	}
 
+	branches = sortBranches(branches, self.UserConfig.Git.BranchSortOrder, committerDateOrder)
+	if self.UserConfig.Git.GroupBranchesByPrefix {
+		branches = groupBranchesByPrefix(branches)
+	}
+
 	configBranches, err := self.config.Branches()
 	if err != nil {
 		return nil, err
@@ -104,9 +123,28 @@ outer:
 		}
 	}
 
+	if self.UserConfig.Gui.ShowDivergenceFromBaseBranch {
+		self.setDivergenceFromBase(branches)
+	}
+
 	return branches, nil
 }
 
+func (self *BranchLoader) setDivergenceFromBase(branches []*models.Branch) {
+	baseBranch := self.getBaseBranch()
+	if baseBranch == "" {
+		return
+	}
+
+	for _, branch := range branches {
+		if branch.Name == baseBranch || !branch.IsRealBranch() {
+			continue
+		}
+
+		branch.AheadOfBase, branch.BehindBase = self.getCommitDifferences(branch.Name, baseBranch)
+	}
+}
+
 func (self *BranchLoader) obtainBranches() []*models.Branch {
 	output, err := self.getRawBranches()
 	if err != nil {
@@ -133,6 +171,62 @@ func (self *BranchLoader) obtainBranches() []*models.Branch {
 	})
 }
 
+// sortBranches sorts the branches, which come to us in recency order, according to
+// the given sort order. The checked-out branch is always kept at the top.
+func sortBranches(branches []*models.Branch, sortOrder string, committerDateOrder map[string]int) []*models.Branch {
+	if len(branches) == 0 {
+		return branches
+	}
+
+	rest := branches[1:]
+	switch sortOrder {
+	case "alphabetical":
+		sort.SliceStable(rest, func(i, j int) bool {
+			return strings.ToLower(rest[i].Name) < strings.ToLower(rest[j].Name)
+		})
+	case "date":
+		sort.SliceStable(rest, func(i, j int) bool {
+			return committerDateOrder[rest[i].Name] < committerDateOrder[rest[j].Name]
+		})
+	}
+
+	return branches
+}
+
+// groupBranchesByPrefix keeps branches with the same prefix (e.g. 'feature/')
+// together. Groups appear in the order of their first branch, so the existing
+// sort order is otherwise preserved. The checked-out branch is kept at the top.
+func groupBranchesByPrefix(branches []*models.Branch) []*models.Branch {
+	if len(branches) == 0 {
+		return branches
+	}
+
+	groups := map[string][]*models.Branch{}
+	prefixes := []string{}
+	for _, branch := range branches[1:] {
+		prefix := branchPrefix(branch.Name)
+		if _, ok := groups[prefix]; !ok {
+			prefixes = append(prefixes, prefix)
+		}
+		groups[prefix] = append(groups[prefix], branch)
+	}
+
+	result := make([]*models.Branch, 0, len(branches))
+	result = append(result, branches[0])
+	for _, prefix := range prefixes {
+		result = append(result, groups[prefix]...)
+	}
+
+	return result
+}
+
+func branchPrefix(branchName string) string {
+	if i := strings.Index(branchName, "/"); i != -1 {
+		return branchName[:i+1]
+	}
+	return ""
+}
+
 // Obtain branch information from parsed line output of getRawBranches()
 // split contains the '|' separated tokens in the line of output
 func obtainBranch(split []string) *models.Branch {
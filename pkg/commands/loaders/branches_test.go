@@ -4,7 +4,9 @@ package loaders
 import (
 	"testing"
 
+	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -50,3 +52,103 @@ func TestObtainBanch(t *testing.T) {
 		})
 	}
 }
+
+func TestSortBranches(t *testing.T) {
+	branchNames := func(branches []*models.Branch) []string {
+		return slices.Map(branches, func(branch *models.Branch) string { return branch.Name })
+	}
+
+	// in recency order, as they come from the loader
+	newBranches := func() []*models.Branch {
+		return []*models.Branch{
+			{Name: "main", Head: true},
+			{Name: "feature/b"},
+			{Name: "fix/a"},
+			{Name: "Develop"},
+			{Name: "feature/a"},
+		}
+	}
+
+	committerDateOrder := map[string]int{
+		"feature/a": 0,
+		"Develop":   1,
+		"main":      2,
+		"fix/a":     3,
+		"feature/b": 4,
+	}
+
+	type scenario struct {
+		testName      string
+		sortOrder     string
+		group         bool
+		expectedNames []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "recency",
+			sortOrder:     "recency",
+			expectedNames: []string{"main", "feature/b", "fix/a", "Develop", "feature/a"},
+		},
+		{
+			testName:      "alphabetical",
+			sortOrder:     "alphabetical",
+			expectedNames: []string{"main", "Develop", "feature/a", "feature/b", "fix/a"},
+		},
+		{
+			testName:      "date",
+			sortOrder:     "date",
+			expectedNames: []string{"main", "feature/a", "Develop", "fix/a", "feature/b"},
+		},
+		{
+			testName:      "recency grouped by prefix",
+			sortOrder:     "recency",
+			group:         true,
+			expectedNames: []string{"main", "feature/b", "feature/a", "fix/a", "Develop"},
+		},
+		{
+			testName:      "date grouped by prefix",
+			sortOrder:     "date",
+			group:         true,
+			expectedNames: []string{"main", "feature/a", "feature/b", "Develop", "fix/a"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			branches := sortBranches(newBranches(), s.sortOrder, committerDateOrder)
+			if s.group {
+				branches = groupBranchesByPrefix(branches)
+			}
+
+			assert.Equal(t, s.expectedNames, branchNames(branches))
+		})
+	}
+}
+
+func TestSetDivergenceFromBase(t *testing.T) {
+	branches := []*models.Branch{
+		{Name: "feature", Pushables: "?", Pullables: "?"},
+		{Name: "main", Pushables: "0", Pullables: "0"},
+		// detached head
+		{Name: "123abc"},
+	}
+
+	loader := &BranchLoader{
+		Common:        utils.NewDummyCommon(),
+		getBaseBranch: func() string { return "main" },
+		getCommitDifferences: func(from, to string) (string, string) {
+			assert.Equal(t, "feature", from)
+			assert.Equal(t, "main", to)
+			return "2", "5"
+		},
+	}
+	loader.setDivergenceFromBase(branches)
+
+	assert.Equal(t, "2", branches[0].AheadOfBase)
+	assert.Equal(t, "5", branches[0].BehindBase)
+	assert.True(t, branches[0].HasDivergenceFromBase())
+	assert.False(t, branches[1].HasDivergenceFromBase())
+	assert.False(t, branches[2].HasDivergenceFromBase())
+}
@@ -35,7 +35,29 @@ func (self *CommitFileLoader) GetFilesInDiff(from string, to string, reverse boo
 		return nil, err
 	}
 
-	return getCommitFilesFromFilenames(filenames), nil
+	commitFiles := getCommitFilesFromFilenames(filenames)
+
+	if self.UserConfig.Gui.ShowFileDiffStats {
+		self.setDiffStats(commitFiles, reverseFlag, from, to)
+	}
+
+	return commitFiles, nil
+}
+
+// the line counts are only a nicety, so if we can't get them we still show the files
+func (self *CommitFileLoader) setDiffStats(commitFiles []*models.CommitFile, reverseFlag string, from string, to string) {
+	numstat, err := self.cmd.New(fmt.Sprintf("git diff --submodule --no-ext-diff --numstat -z --no-renames %s %s %s", reverseFlag, from, to)).DontLog().RunWithOutput()
+	if err != nil {
+		self.Log.Error(err)
+		return
+	}
+
+	diffStats := parseNumstat(numstat)
+	for _, commitFile := range commitFiles {
+		diffStat := diffStats[commitFile.Name]
+		commitFile.LinesAdded = diffStat.linesAdded
+		commitFile.LinesDeleted = diffStat.linesDeleted
+	}
 }
 
 // filenames string is something like "MM\x00file1\x00MU\x00file2\x00AA\x00file3\x00"
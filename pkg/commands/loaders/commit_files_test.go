@@ -1,9 +1,12 @@
 package loaders
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -69,3 +72,49 @@ func TestGetCommitFilesFromFilenames(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFilesInDiffWithDiffStats(t *testing.T) {
+	type scenario struct {
+		testName      string
+		runner        *oscommands.FakeCmdObjRunner
+		expectedFiles []*models.CommitFile
+	}
+
+	filesCmd := `git diff --submodule --no-ext-diff --name-status -z --no-renames  abc123^ abc123`
+	numstatCmd := `git diff --submodule --no-ext-diff --numstat -z --no-renames  abc123^ abc123`
+
+	scenarios := []scenario{
+		{
+			testName: "with line counts",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(filesCmd, "M\x00file.txt\x00", nil).
+				Expect(numstatCmd, "3\t1\tfile.txt\x00", nil),
+			expectedFiles: []*models.CommitFile{
+				{Name: "file.txt", ChangeStatus: "M", LinesAdded: 3, LinesDeleted: 1},
+			},
+		},
+		{
+			testName: "numstat failing",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(filesCmd, "M\x00file.txt\x00", nil).
+				Expect(numstatCmd, "", errors.New("error")),
+			expectedFiles: []*models.CommitFile{
+				{Name: "file.txt", ChangeStatus: "M"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			common := utils.NewDummyCommon()
+			common.UserConfig.Gui.ShowFileDiffStats = true
+			loader := NewCommitFileLoader(common, oscommands.NewDummyCmdObjBuilder(s.runner))
+
+			files, err := loader.GetFilesInDiff("abc123^", "abc123", false)
+			assert.NoError(t, err)
+			assert.EqualValues(t, s.expectedFiles, files)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/samber/lo"
 )
 
 // context:
@@ -30,11 +31,11 @@ type CommitLoader struct {
 	*common.Common
 	cmd oscommands.ICmdObjBuilder
 
-	getCurrentBranchName func() (string, string, error)
-	getRebaseMode        func() (enums.RebaseMode, error)
-	readFile             func(filename string) ([]byte, error)
-	walkFiles            func(root string, fn filepath.WalkFunc) error
-	dotGitDir            string
+	getBaseBranch func() string
+	getRebaseMode func() (enums.RebaseMode, error)
+	readFile      func(filename string) ([]byte, error)
+	walkFiles     func(root string, fn filepath.WalkFunc) error
+	dotGitDir     string
 }
 
 // making our dependencies explicit for the sake of easier testing
@@ -42,17 +43,17 @@ func NewCommitLoader(
 	cmn *common.Common,
 	cmd oscommands.ICmdObjBuilder,
 	dotGitDir string,
-	getCurrentBranchName func() (string, string, error),
+	getBaseBranch func() string,
 	getRebaseMode func() (enums.RebaseMode, error),
 ) *CommitLoader {
 	return &CommitLoader{
-		Common:               cmn,
-		cmd:                  cmd,
-		getCurrentBranchName: getCurrentBranchName,
-		getRebaseMode:        getRebaseMode,
-		readFile:             ioutil.ReadFile,
-		walkFiles:            filepath.Walk,
-		dotGitDir:            dotGitDir,
+		Common:        cmn,
+		cmd:           cmd,
+		getBaseBranch: getBaseBranch,
+		getRebaseMode: getRebaseMode,
+		readFile:      ioutil.ReadFile,
+		walkFiles:     filepath.Walk,
+		dotGitDir:     dotGitDir,
 	}
 }
 
@@ -91,6 +92,21 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	}
 
 	err = self.getLogCmd(opts).RunAndProcessLines(func(line string) (bool, error) {
+		if self.UserConfig.Git.Log.ShowDiffStats {
+			// with --shortstat, a commit's line is followed by a line like
+			// ' 1 file changed, 2 insertions(+)' and then an empty line, unless
+			// the commit has no changes (e.g. a merge commit)
+			if line == "" {
+				return false, nil
+			}
+			if strings.HasPrefix(line, " ") && len(commits) > 0 {
+				diffStat := parseShortstat(line)
+				commits[len(commits)-1].LinesAdded = diffStat.linesAdded
+				commits[len(commits)-1].LinesDeleted = diffStat.linesDeleted
+				return false, nil
+			}
+		}
+
 		commit := self.extractCommitFromLine(line)
 		if commit.Sha == firstPushedCommit {
 			passedFirstPushedCommit = true
@@ -156,8 +172,15 @@ func (self *CommitLoader) MergeRebasingCommits(commits []*models.Commit) ([]*mod
 // then puts them into a commit object
 // example input:
 // 8ad01fe32fcc20f07bc6693f87aa4977c327f1e1|10 hours ago|Jesse Duffield| (HEAD -> master, tag: v0.15.2)|refresh commits when adding a tag
+// If signatures are being shown, the signature status and signer come before the message:
+// 8ad01fe32fcc20f07bc6693f87aa4977c327f1e1|10 hours ago|Jesse Duffield| (HEAD -> master, tag: v0.15.2)|G|Jesse Duffield <jessedduffield@gmail.com>|refresh commits when adding a tag
 func (self *CommitLoader) extractCommitFromLine(line string) *models.Commit {
-	split := strings.SplitN(line, "\x00", 7)
+	showSignatures := self.UserConfig.Git.Log.ShowSignatures
+	fieldCount := 7
+	if showSignatures {
+		fieldCount = 9
+	}
+	split := strings.SplitN(line, "\x00", fieldCount)
 
 	sha := split[0]
 	unixTimestamp := split[1]
@@ -165,7 +188,14 @@ func (self *CommitLoader) extractCommitFromLine(line string) *models.Commit {
 	authorEmail := split[3]
 	extraInfo := strings.TrimSpace(split[4])
 	parentHashes := split[5]
-	message := split[6]
+	message := split[fieldCount-1]
+
+	signatureStatus := models.SignatureStatusNone
+	signer := ""
+	if showSignatures {
+		signatureStatus = models.ParseSignatureStatus(split[6])
+		signer = split[7]
+	}
 
 	tags := []string{}
 
@@ -193,6 +223,9 @@ func (self *CommitLoader) extractCommitFromLine(line string) *models.Commit {
 		AuthorName:    authorName,
 		AuthorEmail:   authorEmail,
 		Parents:       parents,
+
+		SignatureStatus: signatureStatus,
+		Signer:          signer,
 	}
 }
 
@@ -206,9 +239,14 @@ func (self *CommitLoader) getHydratedRebasingCommits(rebaseMode enums.RebaseMode
 		return nil, nil
 	}
 
-	commitShas := slices.Map(commits, func(commit *models.Commit) string {
-		return commit.Sha
+	// todos that aren't about a single commit (e.g. exec or break lines) have no
+	// sha, so there's nothing for git to show for them
+	commitShas := slices.FilterMap(commits, func(commit *models.Commit) (string, bool) {
+		return commit.Sha, commit.Sha != ""
 	})
+	if len(commitShas) == 0 {
+		return commits, nil
+	}
 
 	// note that we're not filtering these as we do non-rebasing commits just because
 	// I suspect that will cause some damage
@@ -216,25 +254,43 @@ func (self *CommitLoader) getHydratedRebasingCommits(rebaseMode enums.RebaseMode
 		fmt.Sprintf(
 			"git -c log.showSignature=false show %s --no-patch --oneline %s --abbrev=%d",
 			strings.Join(commitShas, " "),
-			prettyFormat,
+			self.prettyFormat(),
 			20,
 		),
 	).DontLog()
 
-	hydratedCommits := make([]*models.Commit, 0, len(commits))
-	i := 0
+	fullCommits := []*models.Commit{}
 	err = cmdObj.RunAndProcessLines(func(line string) (bool, error) {
-		commit := self.extractCommitFromLine(line)
-		matchingCommit := commits[i]
-		commit.Action = matchingCommit.Action
-		commit.Status = matchingCommit.Status
-		hydratedCommits = append(hydratedCommits, commit)
-		i++
+		fullCommits = append(fullCommits, self.extractCommitFromLine(line))
 		return false, nil
 	})
 	if err != nil {
 		return nil, err
 	}
+
+	// the todo file may have abbreviated shas, so we match them up by prefix, and
+	// keep the todos without a sha where they are
+	hydratedCommits := make([]*models.Commit, 0, len(commits))
+	for _, commit := range commits {
+		if commit.Sha == "" {
+			hydratedCommits = append(hydratedCommits, commit)
+			continue
+		}
+
+		fullCommit, ok := lo.Find(fullCommits, func(fullCommit *models.Commit) bool {
+			return strings.HasPrefix(fullCommit.Sha, commit.Sha)
+		})
+		if !ok {
+			hydratedCommits = append(hydratedCommits, commit)
+			continue
+		}
+
+		hydratedCommit := *fullCommit
+		hydratedCommit.Action = commit.Action
+		hydratedCommit.Status = commit.Status
+		hydratedCommits = append(hydratedCommits, &hydratedCommit)
+	}
+
 	return hydratedCommits, nil
 }
 
@@ -299,7 +355,7 @@ func (self *CommitLoader) getNormalRebasingCommits() ([]*models.Commit, error) {
 
 // getInteractiveRebasingCommits takes our git-rebase-todo and our git-rebase-todo.backup files
 // and extracts out the sha and names of commits that we still have to go
-// in the rebase:
+// in the rebase, along with any other todos like exec or break lines:
 func (self *CommitLoader) getInteractiveRebasingCommits() ([]*models.Commit, error) {
 	bytesContent, err := self.readFile(filepath.Join(self.dotGitDir, "rebase-merge/git-rebase-todo"))
 	if err != nil {
@@ -317,13 +373,12 @@ func (self *CommitLoader) getInteractiveRebasingCommits() ([]*models.Commit, err
 	}
 
 	for _, t := range todos {
-		if t.Commit == "" {
-			// Command does not have a commit associated, skip
+		if t.Command == todo.Comment {
 			continue
 		}
 		commits = slices.Prepend(commits, &models.Commit{
 			Sha:    t.Commit,
-			Name:   t.Msg,
+			Name:   todoDescription(t),
 			Status: "rebasing",
 			Action: t.Command.String(),
 		})
@@ -332,6 +387,25 @@ func (self *CommitLoader) getInteractiveRebasingCommits() ([]*models.Commit, err
 	return commits, nil
 }
 
+// todoDescription returns what we show in the commits panel for a todo. For
+// lines that aren't about a single commit (e.g. exec or label lines) we show
+// their argument in place of a commit message.
+func todoDescription(t todo.Todo) string {
+	switch t.Command {
+	case todo.Exec:
+		return t.ExecCommand
+	case todo.Label, todo.Reset:
+		return t.Label
+	case todo.Merge:
+		if t.Msg != "" {
+			return t.Msg
+		}
+		return t.Label
+	default:
+		return t.Msg
+	}
+}
+
 // assuming the file starts like this:
 // From e93d4193e6dd45ca9cf3a5a273d7ba6cd8b8fb20 Mon Sep 17 00:00:00 2001
 // From: Lazygit Tester <test@example.com>
@@ -348,11 +422,10 @@ func (self *CommitLoader) commitFromPatch(content string) *models.Commit {
 	}
 }
 
+// setCommitMergedStatuses marks the pushed commits which are already on the base
+// branch as merged
 func (self *CommitLoader) setCommitMergedStatuses(refName string, commits []*models.Commit) ([]*models.Commit, error) {
-	ancestor, err := self.getMergeBase(refName)
-	if err != nil {
-		return nil, err
-	}
+	ancestor := self.getMergeBase(refName)
 	if ancestor == "" {
 		return commits, nil
 	}
@@ -371,20 +444,15 @@ func (self *CommitLoader) setCommitMergedStatuses(refName string, commits []*mod
 	return commits, nil
 }
 
-func (self *CommitLoader) getMergeBase(refName string) (string, error) {
-	currentBranch, _, err := self.getCurrentBranchName()
-	if err != nil {
-		return "", err
-	}
-
-	baseBranch := "master"
-	if strings.HasPrefix(currentBranch, "feature/") {
-		baseBranch = "develop"
+func (self *CommitLoader) getMergeBase(refName string) string {
+	baseBranch := self.getBaseBranch()
+	if baseBranch == "" {
+		return ""
 	}
 
 	// swallowing error because it's not a big deal; probably because there are no commits yet
 	output, _ := self.cmd.New(fmt.Sprintf("git merge-base %s %s", self.cmd.Quote(refName), self.cmd.Quote(baseBranch))).DontLog().RunWithOutput()
-	return ignoringWarnings(output), nil
+	return ignoringWarnings(output)
 }
 
 func ignoringWarnings(commandOutput string) string {
@@ -433,15 +501,21 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 		allFlag = " --all"
 	}
 
+	shortstatFlag := ""
+	if config.ShowDiffStats {
+		shortstatFlag = " --shortstat"
+	}
+
 	return self.cmd.New(
 		fmt.Sprintf(
-			"git -c log.showSignature=false log %s %s %s --oneline %s%s --abbrev=%d%s",
+			"git -c log.showSignature=false log %s %s %s --oneline %s%s --abbrev=%d%s%s",
 			self.cmd.Quote(opts.RefName),
 			orderFlag,
 			allFlag,
-			prettyFormat,
+			self.prettyFormat(),
 			limitFlag,
 			40,
+			shortstatFlag,
 			filterFlag,
 		),
 	).DontLog()
@@ -457,4 +531,26 @@ var prettyFormat = fmt.Sprintf(
 	NULL_CODE,
 )
 
+// %G? and %GS make git verify each signed commit, which is slow enough that
+// we only ask for them when the user wants to see signatures
+var prettyFormatWithSignatures = fmt.Sprintf(
+	"--pretty=format:\"%%H%s%%at%s%%aN%s%%ae%s%%d%s%%p%s%%G?%s%%GS%s%%s\"",
+	NULL_CODE,
+	NULL_CODE,
+	NULL_CODE,
+	NULL_CODE,
+	NULL_CODE,
+	NULL_CODE,
+	NULL_CODE,
+	NULL_CODE,
+)
+
+func (self *CommitLoader) prettyFormat() string {
+	if self.UserConfig.Git.Log.ShowSignatures {
+		return prettyFormatWithSignatures
+	}
+
+	return prettyFormat
+}
+
 const NULL_CODE = "%x00"
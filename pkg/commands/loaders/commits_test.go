@@ -1,6 +1,7 @@
 package loaders
 
 import (
+	"errors"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -21,23 +22,35 @@ d8084cd558925eb7c9c38afeed5725c21653ab90|1640821426|Jesse Duffield|jessedduffiel
 3d4470a6c072208722e5ae9a54bcb9634959a1c5|1640748818|Jesse Duffield|jessedduffield@gmail.com||053a66a7be3da43aacdc|WIP
 053a66a7be3da43aacdc7aa78e1fe757b82c4dd2|1640739815|Jesse Duffield|jessedduffield@gmail.com||985fe482e806b172aea4|refactoring the config struct`, "|", "\x00", -1)
 
+var signedCommitsOutput = strings.Replace(`0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com||b21997d6b4cbdf84b149|G|Jesse Duffield <jessedduffield@gmail.com>|signed commit
+b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164|1640824515|Jesse Duffield|jessedduffield@gmail.com|||N||unsigned commit`, "|", "\x00", -1)
+
+var diffStatCommitsOutput = strings.Replace(`0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com||b21997d6b4cbdf84b149 e94e8fc5b6fab4cb755f|merge commit
+b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164|1640824515|Jesse Duffield|jessedduffield@gmail.com||e94e8fc5b6fab4cb755f|fix logging
+ 3 files changed, 10 insertions(+), 2 deletions(-)
+
+e94e8fc5b6fab4cb755f29f1bdb3ee5e001df35c|1640823749|Jesse Duffield|jessedduffield@gmail.com|||refactor
+ 1 file changed, 1 deletion(-)`, "|", "\x00", -1)
+
 func TestGetCommits(t *testing.T) {
 	type scenario struct {
-		testName          string
-		runner            *oscommands.FakeCmdObjRunner
-		expectedCommits   []*models.Commit
-		expectedError     error
-		rebaseMode        enums.RebaseMode
-		currentBranchName string
-		opts              GetCommitsOptions
+		testName        string
+		runner          *oscommands.FakeCmdObjRunner
+		expectedCommits []*models.Commit
+		expectedError   error
+		rebaseMode      enums.RebaseMode
+		baseBranch      string
+		opts            GetCommitsOptions
+		showSignatures  bool
+		showDiffStats   bool
 	}
 
 	scenarios := []scenario{
 		{
-			testName:          "should return no commits if there are none",
-			rebaseMode:        enums.REBASE_MODE_NONE,
-			currentBranchName: "master",
-			opts:              GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: false},
+			testName:   "should return no commits if there are none",
+			rebaseMode: enums.REBASE_MODE_NONE,
+			baseBranch: "master",
+			opts:       GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: false},
 			runner: oscommands.NewFakeRunner(t).
 				Expect(`git merge-base "HEAD" "HEAD"@{u}`, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				Expect(`git -c log.showSignature=false log "HEAD" --topo-order  --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" --abbrev=40`, "", nil),
@@ -46,16 +59,16 @@ func TestGetCommits(t *testing.T) {
 			expectedError:   nil,
 		},
 		{
-			testName:          "should return commits if they are present",
-			rebaseMode:        enums.REBASE_MODE_NONE,
-			currentBranchName: "master",
-			opts:              GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: false},
+			testName:   "should return commits if they are present",
+			rebaseMode: enums.REBASE_MODE_NONE,
+			baseBranch: "master",
+			opts:       GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: false},
 			runner: oscommands.NewFakeRunner(t).
 				// here it's seeing which commits are yet to be pushed
 				Expect(`git merge-base "HEAD" "HEAD"@{u}`, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				// here it's actually getting all the commits in a formatted form, one per line
 				Expect(`git -c log.showSignature=false log "HEAD" --topo-order  --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" --abbrev=40`, commitsOutput, nil).
-				// here it's seeing where our branch diverged from the master branch so that we can mark that commit and parent commits as 'merged'
+				// here it's seeing where our branch diverged from the base branch so that we can mark that commit and parent commits as 'merged'
 				Expect(`git merge-base "HEAD" "master"`, "26c07b1ab33860a1a7591a0638f9925ccf497ffa", nil),
 
 			expectedCommits: []*models.Commit{
@@ -174,17 +187,137 @@ func TestGetCommits(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			testName:       "should load signature statuses when enabled",
+			rebaseMode:     enums.REBASE_MODE_NONE,
+			baseBranch:     "master",
+			opts:           GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: false},
+			showSignatures: true,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git merge-base "HEAD" "HEAD"@{u}`, "", errors.New("no upstream")).
+				Expect(`git -c log.showSignature=false log "HEAD" --topo-order  --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%G?%x00%GS%x00%s" --abbrev=40`, signedCommitsOutput, nil).
+				Expect(`git merge-base "HEAD" "master"`, "", nil),
+
+			expectedCommits: []*models.Commit{
+				{
+					Sha:             "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+					Name:            "signed commit",
+					Status:          "pushed",
+					Tags:            []string{},
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640826609,
+					Parents:         []string{"b21997d6b4cbdf84b149"},
+					SignatureStatus: models.SignatureStatusGood,
+					Signer:          "Jesse Duffield <jessedduffield@gmail.com>",
+				},
+				{
+					Sha:             "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164",
+					Name:            "unsigned commit",
+					Status:          "pushed",
+					Tags:            []string{},
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640824515,
+					Parents:         []string{},
+					SignatureStatus: models.SignatureStatusNone,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			testName:      "should load diff stats when enabled",
+			rebaseMode:    enums.REBASE_MODE_NONE,
+			baseBranch:    "",
+			opts:          GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: false},
+			showDiffStats: true,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git merge-base "HEAD" "HEAD"@{u}`, "", errors.New("no upstream")).
+				Expect(`git -c log.showSignature=false log "HEAD" --topo-order  --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" --abbrev=40 --shortstat`, diffStatCommitsOutput, nil),
+
+			expectedCommits: []*models.Commit{
+				{
+					Sha:           "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+					Name:          "merge commit",
+					Status:        "pushed",
+					Tags:          []string{},
+					AuthorName:    "Jesse Duffield",
+					AuthorEmail:   "jessedduffield@gmail.com",
+					UnixTimestamp: 1640826609,
+					Parents:       []string{"b21997d6b4cbdf84b149", "e94e8fc5b6fab4cb755f"},
+				},
+				{
+					Sha:           "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164",
+					Name:          "fix logging",
+					Status:        "pushed",
+					Tags:          []string{},
+					AuthorName:    "Jesse Duffield",
+					AuthorEmail:   "jessedduffield@gmail.com",
+					UnixTimestamp: 1640824515,
+					Parents:       []string{"e94e8fc5b6fab4cb755f"},
+					LinesAdded:    10,
+					LinesDeleted:  2,
+				},
+				{
+					Sha:           "e94e8fc5b6fab4cb755f29f1bdb3ee5e001df35c",
+					Name:          "refactor",
+					Status:        "pushed",
+					Tags:          []string{},
+					AuthorName:    "Jesse Duffield",
+					AuthorEmail:   "jessedduffield@gmail.com",
+					UnixTimestamp: 1640823749,
+					Parents:       []string{},
+					LinesDeleted:  1,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			testName:       "should not look for merged commits when there is no base branch",
+			rebaseMode:     enums.REBASE_MODE_NONE,
+			baseBranch:     "",
+			opts:           GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: false},
+			showSignatures: true,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git merge-base "HEAD" "HEAD"@{u}`, "", errors.New("no upstream")).
+				Expect(`git -c log.showSignature=false log "HEAD" --topo-order  --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%G?%x00%GS%x00%s" --abbrev=40`, signedCommitsOutput, nil),
+
+			expectedCommits: []*models.Commit{
+				{
+					Sha:             "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+					Name:            "signed commit",
+					Status:          "pushed",
+					Tags:            []string{},
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640826609,
+					Parents:         []string{"b21997d6b4cbdf84b149"},
+					SignatureStatus: models.SignatureStatusGood,
+					Signer:          "Jesse Duffield <jessedduffield@gmail.com>",
+				},
+				{
+					Sha:             "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164",
+					Name:            "unsigned commit",
+					Status:          "pushed",
+					Tags:            []string{},
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640824515,
+					Parents:         []string{},
+					SignatureStatus: models.SignatureStatusNone,
+				},
+			},
+			expectedError: nil,
+		},
 	}
 
 	for _, scenario := range scenarios {
 		scenario := scenario
 		t.Run(scenario.testName, func(t *testing.T) {
 			builder := &CommitLoader{
-				Common: utils.NewDummyCommon(),
-				cmd:    oscommands.NewDummyCmdObjBuilder(scenario.runner),
-				getCurrentBranchName: func() (string, string, error) {
-					return scenario.currentBranchName, scenario.currentBranchName, nil
-				},
+				Common:        utils.NewDummyCommon(),
+				cmd:           oscommands.NewDummyCmdObjBuilder(scenario.runner),
+				getBaseBranch: func() string { return scenario.baseBranch },
 				getRebaseMode: func() (enums.RebaseMode, error) { return scenario.rebaseMode, nil },
 				dotGitDir:     ".git",
 				readFile: func(filename string) ([]byte, error) {
@@ -194,6 +327,8 @@ func TestGetCommits(t *testing.T) {
 					return nil
 				},
 			}
+			builder.UserConfig.Git.Log.ShowSignatures = scenario.showSignatures
+			builder.UserConfig.Git.Log.ShowDiffStats = scenario.showDiffStats
 
 			commits, err := builder.GetCommits(scenario.opts)
 
@@ -204,3 +339,91 @@ func TestGetCommits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetInteractiveRebasingCommits(t *testing.T) {
+	todo := `pick 0eea75e8c631fba6b58135697835d58ba4c18dbc first commit
+exec make test
+label onto
+reset onto
+merge -C b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164 feature # Merge branch 'feature'
+break
+
+# Rebase 985fe48..0eea75e onto 985fe48 (5 commands)
+#
+# Commands:
+# p, pick <commit> = use commit
+`
+
+	builder := &CommitLoader{
+		Common:    utils.NewDummyCommon(),
+		dotGitDir: ".git",
+		readFile: func(filename string) ([]byte, error) {
+			return []byte(todo), nil
+		},
+	}
+
+	commits, err := builder.getInteractiveRebasingCommits()
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Commit{
+		{Sha: "", Name: "", Status: "rebasing", Action: "break"},
+		{Sha: "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", Name: "Merge branch 'feature'", Status: "rebasing", Action: "merge"},
+		{Sha: "", Name: "onto", Status: "rebasing", Action: "reset"},
+		{Sha: "", Name: "onto", Status: "rebasing", Action: "label"},
+		{Sha: "", Name: "make test", Status: "rebasing", Action: "exec"},
+		{Sha: "0eea75e8c631fba6b58135697835d58ba4c18dbc", Name: "first commit", Status: "rebasing", Action: "pick"},
+	}, commits)
+}
+
+func TestGetHydratedRebasingCommits(t *testing.T) {
+	todo := `pick 0eea75e first commit
+exec make test
+pick b21997d second commit
+break
+`
+
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git -c log.showSignature=false show b21997d 0eea75e --no-patch --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" --abbrev=20`,
+			strings.Replace(`b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164|1640824515|Jesse Duffield|jessedduffield@gmail.com||e94e8fc5b6fab4cb755f|second commit
+0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com||b21997d6b4cbdf84b149|first commit`, "|", "\x00", -1),
+			nil)
+
+	builder := &CommitLoader{
+		Common:    utils.NewDummyCommon(),
+		cmd:       oscommands.NewDummyCmdObjBuilder(runner),
+		dotGitDir: ".git",
+		readFile: func(filename string) ([]byte, error) {
+			return []byte(todo), nil
+		},
+	}
+
+	commits, err := builder.getHydratedRebasingCommits(enums.REBASE_MODE_INTERACTIVE)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Commit{
+		{Sha: "", Name: "", Status: "rebasing", Action: "break"},
+		{
+			Sha:           "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164",
+			Name:          "second commit",
+			Status:        "rebasing",
+			Action:        "pick",
+			Tags:          []string{},
+			AuthorName:    "Jesse Duffield",
+			AuthorEmail:   "jessedduffield@gmail.com",
+			UnixTimestamp: 1640824515,
+			Parents:       []string{"e94e8fc5b6fab4cb755f"},
+		},
+		{Sha: "", Name: "make test", Status: "rebasing", Action: "exec"},
+		{
+			Sha:           "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+			Name:          "first commit",
+			Status:        "rebasing",
+			Action:        "pick",
+			Tags:          []string{},
+			AuthorName:    "Jesse Duffield",
+			AuthorEmail:   "jessedduffield@gmail.com",
+			UnixTimestamp: 1640826609,
+			Parents:       []string{"b21997d6b4cbdf84b149"},
+		},
+	}, commits)
+
+	runner.CheckForMissingCalls()
+}
@@ -31,6 +31,8 @@ func NewFileLoader(cmn *common.Common, cmd oscommands.ICmdObjBuilder, config Fil
 
 type GetStatusFileOptions struct {
 	NoRenames bool
+	// also load how many lines were added and deleted in each file
+	DiffStats bool
 }
 
 func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File {
@@ -65,9 +67,33 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		files = append(files, file)
 	}
 
+	if opts.DiffStats {
+		self.setDiffStats(files)
+	}
+
 	return files
 }
 
+// setDiffStats adds up the line counts of the unstaged and staged changes of
+// each file
+func (self *FileLoader) setDiffStats(files []*models.File) {
+	for _, cmdStr := range []string{"git diff --no-ext-diff --numstat -z", "git diff --no-ext-diff --cached --numstat -z"} {
+		output, err := self.cmd.New(cmdStr).DontLog().RunWithOutput()
+		if err != nil {
+			self.Log.Error(err)
+			continue
+		}
+
+		diffStats := parseNumstat(output)
+		for _, file := range files {
+			if diffStat, ok := diffStats[file.Name]; ok {
+				file.LinesAdded += diffStat.linesAdded
+				file.LinesDeleted += diffStat.linesDeleted
+			}
+		}
+	}
+}
+
 // GitStatus returns the file status of the repo
 type GitStatusOptions struct {
 	NoRenames         bool
@@ -0,0 +1,62 @@
+package loaders
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type diffStat struct {
+	linesAdded   int
+	linesDeleted int
+}
+
+// parseNumstat takes the output of 'git diff --numstat -z' and returns the line
+// counts keyed by path. Each record looks like "1\t2\tpath\x00" or, for a
+// rename, "1\t2\t\x00oldpath\x00newpath\x00", in which case we key by the new
+// path. Binary files have '-' for their counts, which we treat as zero
+func parseNumstat(output string) map[string]diffStat {
+	result := map[string]diffStat{}
+
+	fields := strings.Split(output, "\x00")
+	for i := 0; i < len(fields); i++ {
+		split := strings.SplitN(fields[i], "\t", 3)
+		if len(split) != 3 {
+			continue
+		}
+
+		path := split[2]
+		if path == "" {
+			if i+2 >= len(fields) {
+				break
+			}
+			path = fields[i+2]
+			i += 2
+		}
+
+		linesAdded, _ := strconv.Atoi(split[0])
+		linesDeleted, _ := strconv.Atoi(split[1])
+		result[path] = diffStat{linesAdded: linesAdded, linesDeleted: linesDeleted}
+	}
+
+	return result
+}
+
+var (
+	shortstatInsertionsRegex = regexp.MustCompile(`(\d+) insertions?\(\+\)`)
+	shortstatDeletionsRegex  = regexp.MustCompile(`(\d+) deletions?\(-\)`)
+)
+
+// parseShortstat takes a line like " 3 files changed, 10 insertions(+), 2 deletions(-)"
+// from 'git log --shortstat', where either count is left out when it's zero
+func parseShortstat(line string) diffStat {
+	result := diffStat{}
+	if match := shortstatInsertionsRegex.FindStringSubmatch(line); match != nil {
+		result.linesAdded, _ = strconv.Atoi(match[1])
+	}
+	if match := shortstatDeletionsRegex.FindStringSubmatch(line); match != nil {
+		result.linesDeleted, _ = strconv.Atoi(match[1])
+	}
+
+	return result
+}
@@ -0,0 +1,57 @@
+package loaders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNumstat(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		input    string
+		expected map[string]diffStat
+	}{
+		{
+			testName: "no files",
+			input:    "",
+			expected: map[string]diffStat{},
+		},
+		{
+			testName: "several files",
+			input:    "1\t2\tfile1\x0010\t0\tdir/file2\x00",
+			expected: map[string]diffStat{
+				"file1":     {linesAdded: 1, linesDeleted: 2},
+				"dir/file2": {linesAdded: 10, linesDeleted: 0},
+			},
+		},
+		{
+			testName: "binary file",
+			input:    "-\t-\timage.png\x00",
+			expected: map[string]diffStat{
+				"image.png": {},
+			},
+		},
+		{
+			testName: "rename",
+			input:    "1\t0\t\x00old\x00new\x003\t4\tfile\x00",
+			expected: map[string]diffStat{
+				"new":  {linesAdded: 1, linesDeleted: 0},
+				"file": {linesAdded: 3, linesDeleted: 4},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, parseNumstat(s.input))
+		})
+	}
+}
+
+func TestParseShortstat(t *testing.T) {
+	assert.Equal(t, diffStat{linesAdded: 10, linesDeleted: 2}, parseShortstat(" 3 files changed, 10 insertions(+), 2 deletions(-)"))
+	assert.Equal(t, diffStat{linesAdded: 1}, parseShortstat(" 1 file changed, 1 insertion(+)"))
+	assert.Equal(t, diffStat{linesDeleted: 1}, parseShortstat(" 1 file changed, 1 deletion(-)"))
+}
@@ -7,9 +7,11 @@ import (
 
 	"github.com/jesseduffield/generics/slices"
 	gogit "github.com/jesseduffield/go-git/v5"
+	"github.com/jesseduffield/go-git/v5/config"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type RemoteLoader struct {
@@ -41,6 +43,12 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 		return nil, err
 	}
 
+	// go-git doesn't know about push URLs or push refspecs so we ask git directly.
+	// git config exits with an error when nothing matches, which just means there's
+	// nothing to add.
+	pushConfigStr, _ := self.cmd.New(`git config --get-regexp "^remote[.].*[.]push(url)?$"`).DontLog().RunWithOutput()
+	pushUrls, pushRefspecs := parseRemotePushConfig(pushConfigStr)
+
 	// first step is to get our remotes from go-git
 	remotes := slices.Map(goGitRemotes, func(goGitRemote *gogit.Remote) *models.Remote {
 		remoteName := goGitRemote.Config().Name
@@ -54,10 +62,22 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 			}
 		})
 
+		headBranch := ""
+		headRe := regexp.MustCompile(fmt.Sprintf(`(?m)^\s*%s/HEAD -> %s/(\S+)`, regexp.QuoteMeta(remoteName), regexp.QuoteMeta(remoteName)))
+		if match := headRe.FindStringSubmatch(remoteBranchesStr); match != nil {
+			headBranch = match[1]
+		}
+
 		return &models.Remote{
-			Name:     goGitRemote.Config().Name,
-			Urls:     goGitRemote.Config().URLs,
-			Branches: branches,
+			Name: goGitRemote.Config().Name,
+			Urls: goGitRemote.Config().URLs,
+			FetchRefspecs: slices.Map(goGitRemote.Config().Fetch, func(refspec config.RefSpec) string {
+				return refspec.String()
+			}),
+			PushUrls:     pushUrls[remoteName],
+			PushRefspecs: pushRefspecs[remoteName],
+			HeadBranch:   headBranch,
+			Branches:     branches,
 		}
 	})
 
@@ -75,3 +95,27 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 
 	return remotes, nil
 }
+
+// parseRemotePushConfig takes lines like 'remote.origin.pushurl git@github.com:foo/bar.git'
+// and returns the push URLs and push refspecs of each remote, keyed by remote name
+func parseRemotePushConfig(output string) (map[string][]string, map[string][]string) {
+	pushUrls := map[string][]string{}
+	pushRefspecs := map[string][]string{}
+
+	for _, line := range utils.SplitLines(output) {
+		key, value, found := strings.Cut(line, " ")
+		if !found || !strings.HasPrefix(key, "remote.") {
+			continue
+		}
+		key = strings.TrimPrefix(key, "remote.")
+
+		// remote names may themselves contain dots so we go by the suffix
+		if remoteName := strings.TrimSuffix(key, ".pushurl"); remoteName != key {
+			pushUrls[remoteName] = append(pushUrls[remoteName], value)
+		} else if remoteName := strings.TrimSuffix(key, ".push"); remoteName != key {
+			pushRefspecs[remoteName] = append(pushRefspecs[remoteName], value)
+		}
+	}
+
+	return pushUrls, pushRefspecs
+}
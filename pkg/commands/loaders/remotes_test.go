@@ -0,0 +1,88 @@
+package loaders
+
+import (
+	"testing"
+
+	gogit "github.com/jesseduffield/go-git/v5"
+	"github.com/jesseduffield/go-git/v5/config"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const remoteBranchesOutput = `  origin/HEAD -> origin/master
+  origin/master
+  origin/feature
+  upstream/master
+`
+
+const remotePushConfigOutput = `remote.origin.pushurl git@github.com:me/fork.git
+remote.origin.push refs/heads/master:refs/for/master
+remote.my.dotted.remote.pushurl https://example.com/repo.git
+`
+
+func TestGetRemotes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git branch -r`, remoteBranchesOutput, nil).
+		Expect(`git config --get-regexp "^remote[.].*[.]push(url)?$"`, remotePushConfigOutput, nil)
+
+	loader := &RemoteLoader{
+		Common: utils.NewDummyCommon(),
+		cmd:    oscommands.NewDummyCmdObjBuilder(runner),
+		getGoGitRemotes: func() ([]*gogit.Remote, error) {
+			return []*gogit.Remote{
+				gogit.NewRemote(nil, &config.RemoteConfig{
+					Name:  "upstream",
+					URLs:  []string{"https://github.com/them/repo.git"},
+					Fetch: []config.RefSpec{"+refs/heads/*:refs/remotes/upstream/*"},
+				}),
+				gogit.NewRemote(nil, &config.RemoteConfig{
+					Name:  "origin",
+					URLs:  []string{"https://github.com/me/fork.git"},
+					Fetch: []config.RefSpec{"+refs/heads/*:refs/remotes/origin/*"},
+				}),
+			}, nil
+		},
+	}
+
+	remotes, err := loader.GetRemotes()
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Remote{
+		{
+			Name:          "origin",
+			Urls:          []string{"https://github.com/me/fork.git"},
+			PushUrls:      []string{"git@github.com:me/fork.git"},
+			FetchRefspecs: []string{"+refs/heads/*:refs/remotes/origin/*"},
+			PushRefspecs:  []string{"refs/heads/master:refs/for/master"},
+			HeadBranch:    "master",
+			Branches: []*models.RemoteBranch{
+				{Name: "HEAD", RemoteName: "origin"},
+				{Name: "master", RemoteName: "origin"},
+				{Name: "feature", RemoteName: "origin"},
+			},
+		},
+		{
+			Name:          "upstream",
+			Urls:          []string{"https://github.com/them/repo.git"},
+			FetchRefspecs: []string{"+refs/heads/*:refs/remotes/upstream/*"},
+			Branches: []*models.RemoteBranch{
+				{Name: "master", RemoteName: "upstream"},
+			},
+		},
+	}, remotes)
+
+	runner.CheckForMissingCalls()
+}
+
+func TestParseRemotePushConfig(t *testing.T) {
+	pushUrls, pushRefspecs := parseRemotePushConfig(remotePushConfigOutput)
+
+	assert.Equal(t, map[string][]string{
+		"origin":           {"git@github.com:me/fork.git"},
+		"my.dotted.remote": {"https://example.com/repo.git"},
+	}, pushUrls)
+	assert.Equal(t, map[string][]string{
+		"origin": {"refs/heads/master:refs/for/master"},
+	}, pushRefspecs)
+}
@@ -1,6 +1,10 @@
 package loaders
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -23,21 +27,63 @@ func NewTagLoader(
 	}
 }
 
-func (self *TagLoader) GetTags() ([]*models.Tag, error) {
-	// get remote branches, sorted  by creation date (descending)
-	// see: https://git-scm.com/docs/git-tag#Documentation/git-tag.txt---sortltkeygt
-	tagsOutput, err := self.cmd.New(`git tag --list --sort=-creatordate`).DontLog().RunWithOutput()
+// for annotated tags, objectname is the sha of the tag object and *objectname is the
+// sha of the commit it points to. For lightweight tags, objectname is the commit sha
+// and *objectname is empty.
+const tagFormat = `%(refname)%00%(objecttype)%00%(taggername)%00%(creatordate:unix)%00%(objectname)%00%(*objectname)%00%(contents:subject)`
+
+// GetTags returns the tags in the given sort order, which is one of date, semver
+// and alphabetical
+func (self *TagLoader) GetTags(sortOrder string) ([]*models.Tag, error) {
+	// see: https://git-scm.com/docs/git-for-each-ref#_field_names
+	tagsOutput, err := self.cmd.New(
+		fmt.Sprintf(`git for-each-ref --sort=%s --format="%s" refs/tags`, tagSortKey(sortOrder), tagFormat),
+	).DontLog().RunWithOutput()
 	if err != nil {
 		return nil, err
 	}
 
 	split := utils.SplitLines(tagsOutput)
 
-	tags := slices.Map(split, func(tagName string) *models.Tag {
-		return &models.Tag{
-			Name: tagName,
+	tags := slices.FilterMap(split, func(line string) (*models.Tag, bool) {
+		fields := strings.Split(line, "\x00")
+		if len(fields) != 7 {
+			return nil, false
 		}
+
+		return obtainTag(fields), true
 	})
 
 	return tags, nil
 }
+
+func obtainTag(fields []string) *models.Tag {
+	timestamp, _ := strconv.ParseInt(fields[3], 10, 64)
+
+	tag := &models.Tag{
+		// not using refname:short because it's ambiguous when a branch has the same name
+		Name:          strings.TrimPrefix(fields[0], "refs/tags/"),
+		IsAnnotated:   fields[1] == "tag",
+		UnixTimestamp: timestamp,
+		TargetSha:     fields[4],
+		Subject:       fields[6],
+	}
+
+	if tag.IsAnnotated {
+		tag.Tagger = fields[2]
+		tag.TargetSha = fields[5]
+	}
+
+	return tag
+}
+
+func tagSortKey(sortOrder string) string {
+	switch sortOrder {
+	case "semver":
+		return "-version:refname"
+	case "alphabetical":
+		return "refname"
+	default:
+		return "-creatordate"
+	}
+}
@@ -9,17 +9,18 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-const tagsOutput = `v0.34
-v0.33
-v0.32.2
-v0.32.1
-v0.32
-testtag
-`
+const tagsOutput = "refs/tags/v0.34\x00tag\x00Jesse Duffield\x001652000000\x00aaaaaaaa00000000000000000000000000000000\x00bbbbbbbb00000000000000000000000000000000\x00release v0.34\n" +
+	"refs/tags/v0.33\x00commit\x00\x001651000000\x00cccccccc00000000000000000000000000000000\x00\x00fix the thing\n" +
+	"refs/tags/testtag\x00commit\x00\x001650000000\x00dddddddd00000000000000000000000000000000\x00\x00\n"
+
+func tagsCmd(sortKey string) string {
+	return "git for-each-ref --sort=" + sortKey + ` --format="%(refname)%00%(objecttype)%00%(taggername)%00%(creatordate:unix)%00%(objectname)%00%(*objectname)%00%(contents:subject)" refs/tags`
+}
 
 func TestGetTags(t *testing.T) {
 	type scenario struct {
 		testName      string
+		sortOrder     string
 		runner        *oscommands.FakeCmdObjRunner
 		expectedTags  []*models.Tag
 		expectedError error
@@ -27,26 +28,57 @@ func TestGetTags(t *testing.T) {
 
 	scenarios := []scenario{
 		{
-			testName: "should return no tags if there are none",
+			testName:  "should return no tags if there are none",
+			sortOrder: "date",
 			runner: oscommands.NewFakeRunner(t).
-				Expect(`git tag --list --sort=-creatordate`, "", nil),
+				Expect(tagsCmd("-creatordate"), "", nil),
 			expectedTags:  []*models.Tag{},
 			expectedError: nil,
 		},
 		{
-			testName: "should return tags if present",
+			testName:  "should return tags if present",
+			sortOrder: "date",
 			runner: oscommands.NewFakeRunner(t).
-				Expect(`git tag --list --sort=-creatordate`, tagsOutput, nil),
+				Expect(tagsCmd("-creatordate"), tagsOutput, nil),
 			expectedTags: []*models.Tag{
-				{Name: "v0.34"},
-				{Name: "v0.33"},
-				{Name: "v0.32.2"},
-				{Name: "v0.32.1"},
-				{Name: "v0.32"},
-				{Name: "testtag"},
+				{
+					Name:          "v0.34",
+					IsAnnotated:   true,
+					Tagger:        "Jesse Duffield",
+					UnixTimestamp: 1652000000,
+					TargetSha:     "bbbbbbbb00000000000000000000000000000000",
+					Subject:       "release v0.34",
+				},
+				{
+					Name:          "v0.33",
+					UnixTimestamp: 1651000000,
+					TargetSha:     "cccccccc00000000000000000000000000000000",
+					Subject:       "fix the thing",
+				},
+				{
+					Name:          "testtag",
+					UnixTimestamp: 1650000000,
+					TargetSha:     "dddddddd00000000000000000000000000000000",
+				},
 			},
 			expectedError: nil,
 		},
+		{
+			testName:  "should sort by version",
+			sortOrder: "semver",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(tagsCmd("-version:refname"), "", nil),
+			expectedTags:  []*models.Tag{},
+			expectedError: nil,
+		},
+		{
+			testName:  "should sort alphabetically",
+			sortOrder: "alphabetical",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(tagsCmd("refname"), "", nil),
+			expectedTags:  []*models.Tag{},
+			expectedError: nil,
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -57,7 +89,7 @@ func TestGetTags(t *testing.T) {
 				cmd:    oscommands.NewDummyCmdObjBuilder(scenario.runner),
 			}
 
-			tags, err := loader.GetTags()
+			tags, err := loader.GetTags(scenario.sortOrder)
 
 			assert.Equal(t, scenario.expectedTags, tags)
 			assert.Equal(t, scenario.expectedError, err)
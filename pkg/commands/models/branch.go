@@ -16,6 +16,11 @@ type Branch struct {
 	// 'git@github.com:tiwood/lazygit.git'
 	UpstreamRemote string
 	UpstreamBranch string
+	// how many commits the branch is ahead of and behind the base branch. These are
+	// empty unless gui.showDivergenceFromBaseBranch is on, and '?' if the counts
+	// couldn't be obtained
+	AheadOfBase string
+	BehindBase  string
 }
 
 func (b *Branch) FullRefName() string {
@@ -64,6 +69,11 @@ func (b *Branch) HasCommitsToPull() bool {
 	return b.RemoteBranchStoredLocally() && b.Pullables != "0"
 }
 
+func (b *Branch) HasDivergenceFromBase() bool {
+	return b.AheadOfBase != "" && b.AheadOfBase != "?" && b.BehindBase != "?" &&
+		(b.AheadOfBase != "0" || b.BehindBase != "0")
+}
+
 // for when we're in a detached head state
 func (b *Branch) IsRealBranch() bool {
 	return b.Pushables != "" && b.Pullables != ""
@@ -14,7 +14,7 @@ type Commit struct {
 	Sha           string
 	Name          string
 	Status        string // one of "unpushed", "pushed", "merged", "rebasing" or "selected"
-	Action        string // one of "", "pick", "edit", "squash", "reword", "drop", "fixup"
+	Action        string // one of "", "pick", "edit", "squash", "reword", "drop", "fixup", or for todos that aren't about a single commit: "exec", "break", "label", "reset", "merge"
 	Tags          []string
 	ExtraInfo     string // something like 'HEAD -> master, tag: v0.15.2'
 	AuthorName    string // something like 'Jesse Duffield'
@@ -23,6 +23,41 @@ type Commit struct {
 
 	// SHAs of parent commits (will be multiple if it's a merge commit)
 	Parents []string
+
+	// only populated when git.log.showSignatures is enabled
+	SignatureStatus SignatureStatus
+	Signer          string // something like 'Jesse Duffield <jessedduffield@gmail.com>'
+
+	// only populated when git.log.showDiffStats is enabled
+	LinesAdded   int
+	LinesDeleted int
+}
+
+// SignatureStatus is a simplified version of the status git reports via %G?
+type SignatureStatus int
+
+const (
+	SignatureStatusNone SignatureStatus = iota
+	SignatureStatusGood
+	SignatureStatusBad
+	SignatureStatusUnknown
+)
+
+// ParseSignatureStatus converts the output of git's %G? placeholder into a SignatureStatus.
+// G: good, B: bad, U: good with unknown validity, X: good but expired,
+// Y: good but made by an expired key, R: good but made by a revoked key,
+// E: cannot be checked (e.g. missing key), N: no signature
+func ParseSignatureStatus(str string) SignatureStatus {
+	switch str {
+	case "G":
+		return SignatureStatusGood
+	case "B", "R":
+		return SignatureStatusBad
+	case "U", "X", "Y", "E":
+		return SignatureStatusUnknown
+	default:
+		return SignatureStatusNone
+	}
 }
 
 func (c *Commit) ShortSha() string {
@@ -53,7 +88,11 @@ func (c *Commit) ID() string {
 }
 
 func (c *Commit) Description() string {
-	return fmt.Sprintf("%s %s", c.Sha[:7], c.Name)
+	return fmt.Sprintf("%s %s", c.ShortSha(), c.Name)
+}
+
+func (c *Commit) IsSigned() bool {
+	return c.SignatureStatus != SignatureStatusNone
 }
 
 func (c *Commit) IsMerge() bool {
@@ -65,3 +104,14 @@ func (c *Commit) IsMerge() bool {
 func (c *Commit) IsTODO() bool {
 	return c.Action != ""
 }
+
+// returns true if this is a TODO whose action can be changed, e.g. from 'pick'
+// to 'squash'. Other TODOs like 'exec' or 'label' lines can only be moved or removed.
+func (c *Commit) IsCommitTODO() bool {
+	switch c.Action {
+	case "pick", "edit", "reword", "squash", "fixup", "drop", "revert":
+		return true
+	default:
+		return false
+	}
+}
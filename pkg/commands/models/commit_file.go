@@ -6,6 +6,10 @@ type CommitFile struct {
 	Name string
 
 	ChangeStatus string // e.g. 'A' for added or 'M' for modified. This is based on the result from git diff --name-status
+
+	// from git diff --numstat. These are zero for binary files
+	LinesAdded   int
+	LinesDeleted int
 }
 
 func (f *CommitFile) ID() string {
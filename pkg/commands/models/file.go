@@ -17,9 +17,15 @@ type File struct {
 	Deleted                 bool
 	HasMergeConflicts       bool
 	HasInlineMergeConflicts bool
+	ResolvedByRerere        bool // conflicts resolved by git rerere, for the user to review
 	DisplayString           string
 	Type                    string // one of 'file', 'directory', and 'other'
 	ShortStatus             string // e.g. 'AD', ' A', 'M ', '??'
+
+	// staged and unstaged line counts combined, from git diff --numstat. These
+	// are zero for untracked and binary files
+	LinesAdded   int
+	LinesDeleted int
 }
 
 // sometimes we need to deal with either a node (which contains a file) or an actual file
@@ -2,9 +2,15 @@ package models
 
 // Remote : A git remote
 type Remote struct {
-	Name     string
-	Urls     []string
-	Branches []*RemoteBranch
+	Name string
+	// the fetch URLs. These are also used for pushing unless PushUrls is set
+	Urls          []string
+	PushUrls      []string
+	FetchRefspecs []string
+	PushRefspecs  []string
+	// the branch that refs/remotes/<remote>/HEAD points to, if known
+	HeadBranch string
+	Branches   []*RemoteBranch
 }
 
 func (r *Remote) RefName() string {
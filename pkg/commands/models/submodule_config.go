@@ -1,9 +1,26 @@
 package models
 
+import "strings"
+
 type SubmoduleConfig struct {
 	Name string
+	// relative to the top-level repo, even for nested submodules
 	Path string
 	Url  string
+
+	// the path of the submodule that this one is nested in, if any
+	ParentPath string
+
+	// the commit checked out in the submodule, and the one recorded for it in its
+	// parent's index. These differ when the submodule has moved on without the
+	// parent being updated
+	Head        string
+	RecordedSha string
+	// empty when the submodule's HEAD is detached
+	Branch      string
+	Initialized bool
+	// whether the submodule has uncommitted changes
+	Dirty bool
 }
 
 func (r *SubmoduleConfig) RefName() string {
@@ -17,3 +34,20 @@ func (r *SubmoduleConfig) ID() string {
 func (r *SubmoduleConfig) Description() string {
 	return r.RefName()
 }
+
+// RelativePath returns the submodule's path relative to its parent
+func (r *SubmoduleConfig) RelativePath() string {
+	if r.ParentPath == "" {
+		return r.Path
+	}
+
+	return strings.TrimPrefix(r.Path, r.ParentPath+"/")
+}
+
+func (r *SubmoduleConfig) IsNested() bool {
+	return r.ParentPath != ""
+}
+
+func (r *SubmoduleConfig) IsOnRecordedCommit() bool {
+	return r.Head == r.RecordedSha
+}
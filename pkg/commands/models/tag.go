@@ -1,8 +1,20 @@
 package models
 
+import "github.com/jesseduffield/lazygit/pkg/utils"
+
 // Tag : A git tag
 type Tag struct {
 	Name string
+	// true for annotated tags, which are objects in their own right with a tagger,
+	// date and message. Lightweight tags are just a ref pointing at a commit
+	IsAnnotated   bool
+	Tagger        string
+	UnixTimestamp int64
+	// the sha of the commit the tag points to
+	TargetSha string
+	// the first line of the tag's message. For lightweight tags this is the
+	// subject of the target commit
+	Subject string
 }
 
 func (t *Tag) FullRefName() string {
@@ -24,3 +36,7 @@ func (t *Tag) ID() string {
 func (t *Tag) Description() string {
 	return "tag " + t.Name
 }
+
+func (t *Tag) ShortTargetSha() string {
+	return utils.ShortSha(t.TargetSha)
+}
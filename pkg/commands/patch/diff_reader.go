@@ -0,0 +1,189 @@
+package patch
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+)
+
+// when streaming a diff we hold back each run of changed lines until we know
+// whether there are added lines to pair the removed lines with, but only up to
+// this many lines so that we don't hold back e.g. all of a deleted file
+const maxDiffReaderBlockLines = 1000
+
+// NewDiffReader wraps the output of a command like 'git diff' or 'git show',
+// emphasising the changed parts of changed lines (see word_diff.go), laying out
+// the diff side by side in the given width (see side_by_side.go) and/or
+// highlighting the syntax of the lines' content (see syntax.go) as the output is
+// read. A sideBySideWidth of zero means we don't lay it out side by side. The
+// output can be coloured already, and lines we don't touch are left as they are.
+func NewDiffReader(r io.Reader, wordDiff string, sideBySideWidth int, syntaxHighlighting bool) io.Reader {
+	if sideBySideWidth < minSideBySideWidth {
+		sideBySideWidth = 0
+	}
+
+	var highlighter *diffHighlighter
+	if syntaxHighlighting {
+		highlighter = &diffHighlighter{}
+	}
+
+	return &diffReader{
+		source:          bufio.NewReader(r),
+		wordDiff:        wordDiff,
+		sideBySideWidth: sideBySideWidth,
+		highlighter:     highlighter,
+	}
+}
+
+type diffReader struct {
+	source          *bufio.Reader
+	wordDiff        string
+	sideBySideWidth int
+	highlighter     *diffHighlighter // nil if we're not highlighting syntax
+	output          bytes.Buffer
+	err             error
+
+	inHunk bool
+	// the run of changed lines we're holding back, as read from the source
+	deletions []string
+	additions []string
+}
+
+func (self *diffReader) Read(p []byte) (int, error) {
+	for self.output.Len() == 0 && self.err == nil {
+		line, err := self.source.ReadString('\n')
+		if line != "" {
+			self.processLine(strings.TrimSuffix(line, "\n"))
+		}
+		if err != nil {
+			self.flushBlock()
+			self.err = err
+		}
+	}
+
+	if self.output.Len() > 0 {
+		return self.output.Read(p)
+	}
+
+	return 0, self.err
+}
+
+func (self *diffReader) sideBySide() bool {
+	return self.sideBySideWidth > 0
+}
+
+func (self *diffReader) processLine(line string) {
+	plain := ansiEscapeRegex.ReplaceAllString(line, "")
+
+	switch {
+	case self.inHunk && strings.HasPrefix(plain, "-"):
+		if len(self.additions) > 0 {
+			self.flushBlock()
+		}
+		self.deletions = append(self.deletions, line)
+	case self.inHunk && strings.HasPrefix(plain, "+") && (len(self.deletions) > 0 || self.sideBySide() || self.highlighter != nil):
+		self.additions = append(self.additions, line)
+	default:
+		self.flushBlock()
+		// combined diffs (e.g. of merge commits) have a column per parent, which
+		// we don't handle
+		if strings.HasPrefix(plain, "@@") {
+			self.inHunk = !strings.HasPrefix(plain, "@@@")
+			if self.highlighter != nil {
+				self.highlighter.hunkStart()
+			}
+		} else if !strings.HasPrefix(plain, " ") && !strings.HasPrefix(plain, "+") && !strings.HasPrefix(plain, "\\") {
+			self.inHunk = false
+		}
+
+		if !self.inHunk && self.highlighter != nil {
+			self.highlighter.headerLine(plain)
+		}
+
+		switch {
+		case self.inHunk && self.sideBySide() && strings.HasPrefix(plain, " "):
+			self.writeRow(contextRow(plain[1:], self.highlighter))
+		case self.inHunk && self.highlighter != nil && strings.HasPrefix(plain, " "):
+			self.writeContentLine(line, CONTEXT, plain[1:], nil)
+		default:
+			self.writeLine(line)
+		}
+	}
+
+	if len(self.deletions)+len(self.additions) >= maxDiffReaderBlockLines {
+		self.flushBlock()
+	}
+}
+
+func (self *diffReader) flushBlock() {
+	plainLines := func(lines []string) []string {
+		result := make([]string, len(lines))
+		for i, line := range lines {
+			result[i] = ansiEscapeRegex.ReplaceAllString(line, "")[1:]
+		}
+		return result
+	}
+	plainDeletions := plainLines(self.deletions)
+	plainAdditions := plainLines(self.additions)
+
+	if self.sideBySide() {
+		for _, row := range sideBySideRowsForBlock(plainDeletions, plainAdditions, self.wordDiff, self.highlighter) {
+			self.writeRow(row)
+		}
+	} else {
+		deletionSpans, additionSpans := changedSpansForBlock(plainDeletions, plainAdditions, self.wordDiff)
+
+		for i, line := range self.deletions {
+			self.writeContentLine(line, DELETION, plainDeletions[i], deletionSpans[i])
+		}
+		for i, line := range self.additions {
+			self.writeContentLine(line, ADDITION, plainAdditions[i], additionSpans[i])
+		}
+	}
+
+	self.deletions = nil
+	self.additions = nil
+}
+
+// writeContentLine writes a line of a hunk, re-rendering it from its plain
+// content if there's anything to emphasise or highlight in it
+func (self *diffReader) writeContentLine(line string, kind PatchLineKind, plainContent string, changedSpans []span) {
+	var syntaxTokens []syntaxToken
+	if self.highlighter != nil {
+		syntaxTokens = self.highlighter.highlight(kind, plainContent)
+	}
+	if len(changedSpans) == 0 && len(syntaxTokens) == 0 {
+		self.writeLine(line)
+		return
+	}
+
+	var textStyle style.TextStyle
+	prefix := " "
+	switch kind {
+	case ADDITION:
+		textStyle = style.FgGreen
+		prefix = "+"
+	case DELETION:
+		textStyle = style.FgRed
+		prefix = "-"
+	default:
+		textStyle = theme.DefaultTextColor
+	}
+
+	self.writeLine(textStyle.Sprint(prefix) + styleLine(textStyle, plainContent, syntaxTokens, changedSpans))
+}
+
+func (self *diffReader) writeRow(row *sideBySideRow) {
+	for _, line := range row.render(columnWidthFor(self.sideBySideWidth)) {
+		self.writeLine(line)
+	}
+}
+
+func (self *diffReader) writeLine(line string) {
+	self.output.WriteString(line)
+	self.output.WriteByte('\n')
+}
@@ -0,0 +1,82 @@
+package patch
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
+
+func TestDiffReaderWordDiff(t *testing.T) {
+	color.ForceSetColorLevel(terminfo.ColorLevelBasic)
+	defer color.ForceSetColorLevel(terminfo.ColorLevelNone)
+
+	output, err := io.ReadAll(NewDiffReader(strings.NewReader(wordDiffPatch), WORD_DIFF_WORD, 0, false))
+	assert.NoError(t, err)
+
+	inputLines := strings.Split(wordDiffPatch, "\n")
+	outputLines := strings.Split(string(output), "\n")
+	assert.Equal(t, len(inputLines), len(outputLines))
+
+	reverse := ";7m"
+	for i, line := range outputLines {
+		assert.Equal(t, inputLines[i], ansiEscapeRegex.ReplaceAllString(line, ""))
+
+		// the paired up lines get emphasised and the rest are left as is
+		if i >= 6 && i <= 9 {
+			assert.Contains(t, line, reverse)
+		} else {
+			assert.Equal(t, inputLines[i], line)
+		}
+	}
+}
+
+func TestDiffReaderSyntaxHighlighting(t *testing.T) {
+	color.ForceSetColorLevel(terminfo.ColorLevelBasic)
+	defer color.ForceSetColorLevel(terminfo.ColorLevelNone)
+
+	output, err := io.ReadAll(NewDiffReader(strings.NewReader(wordDiffPatch), WORD_DIFF_OFF, 0, true))
+	assert.NoError(t, err)
+
+	inputLines := strings.Split(wordDiffPatch, "\n")
+	outputLines := strings.Split(string(output), "\n")
+	assert.Equal(t, len(inputLines), len(outputLines))
+
+	magenta := "35m"
+	for i, line := range outputLines {
+		assert.Equal(t, inputLines[i], ansiEscapeRegex.ReplaceAllString(line, ""))
+
+		// every line of the hunk starts with a keyword, and the headers are left
+		// as they are
+		if i >= 5 && i <= 11 {
+			assert.Contains(t, line, magenta)
+		} else {
+			assert.Equal(t, inputLines[i], line)
+		}
+	}
+}
+
+func TestDiffReaderSideBySide(t *testing.T) {
+	color.ForceSetColorLevel(terminfo.ColorLevelNone)
+
+	output, err := io.ReadAll(NewDiffReader(strings.NewReader(wordDiffPatch), WORD_DIFF_OFF, 24, false))
+	assert.NoError(t, err)
+
+	expected := `diff --git a/file.go b/file.go
+index 1234567..89abcde 100644
+--- a/file.go
++++ b/file.go
+@@ -1,5 +1,5 @@
+ package ma│ package ma
+ in        │ in        
+-var a = 1 │+var a = 10
+-var b = 2 │+var c = 2 
+           │+var d = 3 
+ func main(│ func main(
+ ) {}      │ ) {}      
+`
+	assert.Equal(t, expected, string(output))
+}
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -54,19 +55,70 @@ func GetHunksFromDiff(diff string) []*PatchHunk {
 	return hunks
 }
 
+// fileHeader is what the header of a file's diff says about the file, other than
+// its content changing
+type fileHeader struct {
+	oldMode       string
+	newMode       string
+	isNewFile     bool
+	isDeletedFile bool
+	renameFrom    string
+	renameTo      string
+}
+
+func parseFileHeader(header string) fileHeader {
+	result := fileHeader{}
+	for _, line := range strings.Split(header, "\n") {
+		switch {
+		case strings.HasPrefix(line, "old mode "):
+			result.oldMode = strings.TrimPrefix(line, "old mode ")
+		case strings.HasPrefix(line, "new mode "):
+			result.newMode = strings.TrimPrefix(line, "new mode ")
+		case strings.HasPrefix(line, "new file mode "):
+			result.isNewFile = true
+			result.newMode = strings.TrimPrefix(line, "new file mode ")
+		case strings.HasPrefix(line, "deleted file mode "):
+			result.isDeletedFile = true
+			result.oldMode = strings.TrimPrefix(line, "deleted file mode ")
+		case strings.HasPrefix(line, "rename from "):
+			result.renameFrom = strings.TrimPrefix(line, "rename from ")
+		case strings.HasPrefix(line, "rename to "):
+			result.renameTo = strings.TrimPrefix(line, "rename to ")
+		}
+	}
+
+	return result
+}
+
+// reversed returns the header of the reverse of the diff
+func (self fileHeader) reversed() fileHeader {
+	return fileHeader{
+		oldMode:       self.newMode,
+		newMode:       self.oldMode,
+		isNewFile:     self.isDeletedFile,
+		isDeletedFile: self.isNewFile,
+		renameFrom:    self.renameTo,
+		renameTo:      self.renameFrom,
+	}
+}
+
 type PatchModifier struct {
-	Log      *logrus.Entry
-	filename string
-	hunks    []*PatchHunk
-	header   string
+	Log        *logrus.Entry
+	filename   string
+	hunks      []*PatchHunk
+	header     string
+	fileHeader fileHeader
 }
 
 func NewPatchModifier(log *logrus.Entry, filename string, diffText string) *PatchModifier {
+	header := GetHeaderFromDiff(diffText)
+
 	return &PatchModifier{
-		Log:      log,
-		filename: filename,
-		hunks:    GetHunksFromDiff(diffText),
-		header:   GetHeaderFromDiff(diffText),
+		Log:        log,
+		filename:   filename,
+		hunks:      GetHunksFromDiff(diffText),
+		header:     header,
+		fileHeader: parseFileHeader(header),
 	}
 }
 
@@ -105,12 +157,65 @@ outer:
 	if keepOriginalHeader {
 		fileHeader = d.header
 	} else {
-		fileHeader = fmt.Sprintf("--- a/%s\n+++ b/%s\n", d.filename, d.filename)
+		fileHeader = d.headerForLines(reverse, d.allChangesSelected(lineIndices))
 	}
 
 	return fileHeader + formattedHunks
 }
 
+// headerForLines returns the header to stage, unstage or discard lines of the
+// file with. When all of the file's changed lines are selected we carry over
+// any change to the file's mode and its deletion or rename, so that applying
+// every line is the same as applying the whole file.
+func (d *PatchModifier) headerForLines(reverse bool, allChangesSelected bool) string {
+	header := d.fileHeader
+	if reverse {
+		header = header.reversed()
+	}
+
+	modeLines := ""
+	if allChangesSelected && header.oldMode != "" && header.newMode != "" && header.oldMode != header.newMode {
+		modeLines = fmt.Sprintf("old mode %s\nnew mode %s\n", header.oldMode, header.newMode)
+	}
+
+	switch {
+	case header.isNewFile:
+		// the file doesn't exist where we're applying the lines, so we create it
+		// with just those lines
+		return fmt.Sprintf("diff --git a/%[1]s b/%[1]s\nnew file mode %[2]s\n--- /dev/null\n+++ b/%[1]s\n", d.filename, header.newMode)
+	case header.isDeletedFile && allChangesSelected:
+		return fmt.Sprintf("diff --git a/%[1]s b/%[1]s\ndeleted file mode %[2]s\n--- a/%[1]s\n+++ /dev/null\n", d.filename, header.oldMode)
+	case header.renameFrom != "" && (!reverse || allChangesSelected):
+		// the lines of a rename only make sense relative to the file's old path,
+		// so we stage the rename along with them. When unstaging or discarding
+		// some of the lines we leave the rename be and just take the lines out
+		// of the renamed file.
+		return fmt.Sprintf(
+			"diff --git a/%[1]s b/%[2]s\n%[3]srename from %[1]s\nrename to %[2]s\n--- a/%[1]s\n+++ b/%[2]s\n",
+			header.renameFrom, header.renameTo, modeLines,
+		)
+	case modeLines != "":
+		return fmt.Sprintf("diff --git a/%[1]s b/%[1]s\n%[2]s--- a/%[1]s\n+++ b/%[1]s\n", d.filename, modeLines)
+	default:
+		return fmt.Sprintf("--- a/%s\n+++ b/%s\n", d.filename, d.filename)
+	}
+}
+
+// allChangesSelected tells us whether every added or removed line of the diff is
+// among the given lines
+func (d *PatchModifier) allChangesSelected(lineIndices []int) bool {
+	for _, hunk := range d.hunks {
+		for i, line := range hunk.bodyLines {
+			lineIdx := hunk.FirstLineIdx + 1 + i
+			if (strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-")) && !lo.Contains(lineIndices, lineIdx) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func (d *PatchModifier) ModifiedPatchForRange(firstLineIdx int, lastLineIdx int, reverse bool, keepOriginalHeader bool) string {
 	// generate array of consecutive line indices from our range
 	selectedLines := []int{}
@@ -138,6 +243,29 @@ func ModifiedPatchForLines(log *logrus.Entry, filename string, diffText string,
 	return p.ModifiedPatchForLines(includedLineIndices, reverse, keepOriginalHeader)
 }
 
+// RecountEditedPatch takes a patch that the user has edited by hand and returns
+// it ready to apply: comment lines are dropped, empty lines (which is what some
+// editors make of context lines for empty lines) become context lines again, and
+// the headers of the hunks are recounted to match their lines. It returns an
+// empty string if no changes are left.
+func RecountEditedPatch(log *logrus.Entry, filename string, editedPatch string) string {
+	lines := []string{}
+	inHunk := false
+	for _, line := range strings.Split(strings.TrimRight(editedPatch, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "@@"):
+			inHunk = true
+		case inHunk && line == "":
+			line = " "
+		}
+		lines = append(lines, line)
+	}
+
+	return ModifiedPatchForRange(log, filename, strings.Join(lines, "\n")+"\n", 0, len(lines), false, false)
+}
+
 // I want to know, given a hunk, what line a given index is on
 func (hunk *PatchHunk) LineNumberOfLine(idx int) int {
 	n := idx - hunk.FirstLineIdx - 1
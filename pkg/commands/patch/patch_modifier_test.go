@@ -80,6 +80,43 @@ index 0000000..4e680cc
 +grape
 `
 
+const deletedFile = `diff --git a/oldfile b/oldfile
+deleted file mode 100755
+index 4e680cc..0000000
+--- a/oldfile
++++ /dev/null
+@@ -1,3 +0,0 @@
+-apple
+-orange
+-grape
+`
+
+const modeChange = `diff --git a/filename b/filename
+old mode 100644
+new mode 100755
+index dcd3485..1ba5540
+--- a/filename
++++ b/filename
+@@ -1,2 +1,2 @@
+ apple
+-orange
++grape
+`
+
+const renamedFile = `diff --git a/oldname b/newname
+similarity index 75%
+rename from oldname
+rename to newname
+index 01e79c3..94ebaf9 100644
+--- a/oldname
++++ b/newname
+@@ -1,3 +1,4 @@
+ apple
+ orange
++grape
++pear
+`
+
 const addNewlineToPreviouslyEmptyFile = `diff --git a/newfile b/newfile
 index e69de29..c6568ea 100644
 --- a/newfile
@@ -443,7 +480,9 @@ func TestModifyPatchForRange(t *testing.T) {
 			lastLineIndex:  100,
 			reverse:        false,
 			diffText:       newFile,
-			expected: `--- a/newfile
+			expected: `diff --git a/newfile b/newfile
+new file mode 100644
+--- /dev/null
 +++ b/newfile
 @@ -0,0 +1,3 @@
 +apple
@@ -458,7 +497,9 @@ func TestModifyPatchForRange(t *testing.T) {
 			lastLineIndex:  7,
 			reverse:        false,
 			diffText:       newFile,
-			expected: `--- a/newfile
+			expected: `diff --git a/newfile b/newfile
+new file mode 100644
+--- /dev/null
 +++ b/newfile
 @@ -0,0 +1,2 @@
 +apple
@@ -472,12 +513,164 @@ func TestModifyPatchForRange(t *testing.T) {
 			lastLineIndex:  100,
 			reverse:        true,
 			diffText:       newFile,
-			expected: `--- a/newfile
-+++ b/newfile
+			expected: `diff --git a/newfile b/newfile
+deleted file mode 100644
+--- a/newfile
++++ /dev/null
 @@ -1,3 +0,0 @@
 -apple
 -orange
 -grape
+`,
+		},
+		{
+			testName:       "deleting a file",
+			filename:       "oldfile",
+			firstLineIndex: -100,
+			lastLineIndex:  100,
+			reverse:        false,
+			diffText:       deletedFile,
+			expected: `diff --git a/oldfile b/oldfile
+deleted file mode 100755
+--- a/oldfile
++++ /dev/null
+@@ -1,3 +0,0 @@
+-apple
+-orange
+-grape
+`,
+		},
+		{
+			testName:       "deleting part of a file",
+			filename:       "oldfile",
+			firstLineIndex: 6,
+			lastLineIndex:  6,
+			reverse:        false,
+			diffText:       deletedFile,
+			expected: `--- a/oldfile
++++ b/oldfile
+@@ -1,3 +1,2 @@
+-apple
+ orange
+ grape
+`,
+		},
+		{
+			testName:       "deleting part of a file, reversed",
+			filename:       "oldfile",
+			firstLineIndex: 6,
+			lastLineIndex:  6,
+			reverse:        true,
+			diffText:       deletedFile,
+			expected: `diff --git a/oldfile b/oldfile
+new file mode 100755
+--- /dev/null
++++ b/oldfile
+@@ -0,0 +1,1 @@
++apple
+`,
+		},
+		{
+			testName:       "changing the mode and all lines of a file",
+			filename:       "filename",
+			firstLineIndex: -100,
+			lastLineIndex:  100,
+			reverse:        false,
+			diffText:       modeChange,
+			expected: `diff --git a/filename b/filename
+old mode 100644
+new mode 100755
+--- a/filename
++++ b/filename
+@@ -1,2 +1,2 @@
+ apple
+-orange
++grape
+`,
+		},
+		{
+			testName:       "changing the mode and all lines of a file, reversed",
+			filename:       "filename",
+			firstLineIndex: -100,
+			lastLineIndex:  100,
+			reverse:        true,
+			diffText:       modeChange,
+			expected: `diff --git a/filename b/filename
+old mode 100755
+new mode 100644
+--- a/filename
++++ b/filename
+@@ -1,2 +1,2 @@
+ apple
++orange
+-grape
+`,
+		},
+		{
+			testName:       "changing the mode and some lines of a file",
+			filename:       "filename",
+			firstLineIndex: 8,
+			lastLineIndex:  8,
+			reverse:        false,
+			diffText:       modeChange,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,2 +1,1 @@
+ apple
+-orange
+`,
+		},
+		{
+			testName:       "part of a renamed file",
+			filename:       "newname",
+			firstLineIndex: 10,
+			lastLineIndex:  10,
+			reverse:        false,
+			diffText:       renamedFile,
+			expected: `diff --git a/oldname b/newname
+rename from oldname
+rename to newname
+--- a/oldname
++++ b/newname
+@@ -1,2 +1,3 @@
+ apple
+ orange
++grape
+`,
+		},
+		{
+			testName:       "part of a renamed file, reversed",
+			filename:       "newname",
+			firstLineIndex: 10,
+			lastLineIndex:  10,
+			reverse:        true,
+			diffText:       renamedFile,
+			expected: `--- a/newname
++++ b/newname
+@@ -1,4 +1,3 @@
+ apple
+ orange
+-grape
+ pear
+`,
+		},
+		{
+			testName:       "all of a renamed file, reversed",
+			filename:       "newname",
+			firstLineIndex: -100,
+			lastLineIndex:  100,
+			reverse:        true,
+			diffText:       renamedFile,
+			expected: `diff --git a/newname b/oldname
+rename from newname
+rename to oldname
+--- a/newname
++++ b/oldname
+@@ -1,4 +1,2 @@
+ apple
+ orange
+-grape
+-pear
 `,
 		},
 		{
@@ -521,6 +714,76 @@ func TestModifyPatchForRange(t *testing.T) {
 	}
 }
 
+func TestRecountEditedPatch(t *testing.T) {
+	type scenario struct {
+		testName    string
+		editedPatch string
+		expected    string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "recounts the hunk",
+			editedPatch: `--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+-orange
++grape
++pear
+ ...
+`,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,3 +1,4 @@
+ apple
+-orange
++grape
++pear
+ ...
+`,
+		},
+		{
+			testName: "drops comments and restores empty context lines",
+			editedPatch: `--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+
+-orange
++grape
+# ---
+# Lines starting with # will be removed.
+`,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,3 +1,3 @@
+ apple
+ 
+-orange
++grape
+`,
+		},
+		{
+			testName: "no changes left",
+			editedPatch: `--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+ orange
+`,
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, RecountEditedPatch(nil, "filename", s.editedPatch))
+		})
+	}
+}
+
 func TestLineNumberOfLine(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -86,7 +86,10 @@ func (p *PatchParser) GetHunkContainingLine(lineIndex int, offset int) *PatchHun
 // selected means you've got it highlighted with your cursor
 // included means the line has been included in the patch (only applicable when
 // building a patch)
-func (l *PatchLine) render(selected bool, included bool) string {
+// changedSpans are the parts of an added or removed line to emphasise because
+// they changed (see word_diff.go), and syntaxTokens are the parts of the line's
+// content to colour by its syntax (see syntax.go)
+func (l *PatchLine) render(selected bool, included bool, changedSpans []span, syntaxTokens []syntaxToken) string {
 	content := l.Content
 	if len(content) == 0 {
 		content = " " // using the space so that we can still highlight if necessary
@@ -113,6 +116,14 @@ func (l *PatchLine) render(selected bool, included bool) string {
 		textStyle = theme.DefaultTextColor
 	}
 
+	if len(changedSpans) > 0 || len(syntaxTokens) > 0 {
+		if selected {
+			textStyle = textStyle.MergeStyle(theme.SelectedRangeBgColor)
+		}
+		// the spans don't include the line's leading '+', '-' or ' '
+		return coloredString(textStyle, content[:1], false, included) + styleLine(textStyle, content[1:], syntaxTokens, changedSpans)
+	}
+
 	return coloredString(textStyle, content, selected, included)
 }
 
@@ -182,8 +193,9 @@ func parsePatch(patch string) ([]int, []int, []*PatchLine) {
 	return hunkStarts, stageableLines, patchLines
 }
 
-// Render returns the coloured string of the diff with any selected lines highlighted
-func (p *PatchParser) Render(isFocused bool, firstLineIndex int, lastLineIndex int, incLineIndices []int) string {
+// Render returns the coloured string of the diff with any selected lines highlighted.
+// wordDiff is one of the WORD_DIFF_* modes.
+func (p *PatchParser) Render(isFocused bool, firstLineIndex int, lastLineIndex int, incLineIndices []int, wordDiff string, syntaxHighlighting bool) string {
 	contentToDisplay := slices.Some(p.PatchLines, func(line *PatchLine) bool {
 		return line.Content != ""
 	})
@@ -191,10 +203,16 @@ func (p *PatchParser) Render(isFocused bool, firstLineIndex int, lastLineIndex i
 		return ""
 	}
 
+	changedSpans := p.changedSpans(wordDiff)
+	syntaxTokens := map[int][]syntaxToken{}
+	if syntaxHighlighting {
+		syntaxTokens = p.syntaxTokens()
+	}
+
 	renderedLines := slices.MapWithIndex(p.PatchLines, func(patchLine *PatchLine, index int) string {
 		selected := isFocused && index >= firstLineIndex && index <= lastLineIndex
 		included := lo.Contains(incLineIndices, index)
-		return patchLine.render(selected, included)
+		return patchLine.render(selected, included, changedSpans[index], syntaxTokens[index])
 	})
 
 	result := strings.Join(renderedLines, "\n")
@@ -202,6 +220,69 @@ func (p *PatchParser) Render(isFocused bool, firstLineIndex int, lastLineIndex i
 	return result
 }
 
+// changedSpans returns the changed spans of each added or removed line, keyed
+// by line index
+func (p *PatchParser) changedSpans(wordDiff string) map[int][]span {
+	result := map[int][]span{}
+	if wordDiff != WORD_DIFF_WORD && wordDiff != WORD_DIFF_CHAR {
+		return result
+	}
+
+	// the spans don't include the line's leading '+' or '-'
+	lineContent := func(line *PatchLine) string {
+		return line.Content[1:]
+	}
+
+	for i := 0; i < len(p.PatchLines); {
+		if p.PatchLines[i].Kind != DELETION {
+			i++
+			continue
+		}
+
+		deletionsStart := i
+		for i < len(p.PatchLines) && p.PatchLines[i].Kind == DELETION {
+			i++
+		}
+		additionsStart := i
+		for i < len(p.PatchLines) && p.PatchLines[i].Kind == ADDITION {
+			i++
+		}
+
+		deletions := slices.Map(p.PatchLines[deletionsStart:additionsStart], lineContent)
+		additions := slices.Map(p.PatchLines[additionsStart:i], lineContent)
+		deletionSpans, additionSpans := changedSpansForBlock(deletions, additions, wordDiff)
+		for offset, spans := range deletionSpans {
+			result[deletionsStart+offset] = spans
+		}
+		for offset, spans := range additionSpans {
+			result[additionsStart+offset] = spans
+		}
+	}
+
+	return result
+}
+
+// syntaxTokens returns the syntax tokens of the content of each line of a hunk,
+// keyed by line index
+func (p *PatchParser) syntaxTokens() map[int][]syntaxToken {
+	result := map[int][]syntaxToken{}
+	highlighter := &diffHighlighter{}
+	for i, line := range p.PatchLines {
+		switch line.Kind {
+		case PATCH_HEADER:
+			highlighter.headerLine(line.Content)
+		case HUNK_HEADER:
+			highlighter.hunkStart()
+		case ADDITION, DELETION, CONTEXT:
+			if line.Content != "" {
+				result[i] = highlighter.highlight(line.Kind, line.Content[1:])
+			}
+		}
+	}
+
+	return result
+}
+
 func (p *PatchParser) RenderPlain() string {
 	return renderLinesPlain(p.PatchLines)
 }
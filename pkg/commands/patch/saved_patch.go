@@ -0,0 +1,200 @@
+package patch
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/generics/maps"
+)
+
+// SavedPatch is what we write to disk so that an in-progress custom patch can be
+// resumed in a later session. We only store the selection: the diffs themselves
+// are reloaded from the commit when the patch is restored.
+type SavedPatch struct {
+	From      string      `yaml:"from"`
+	To        string      `yaml:"to"`
+	Reverse   bool        `yaml:"reverse"`
+	CanRebase bool        `yaml:"canRebase"`
+	Files     []SavedFile `yaml:"files"`
+}
+
+type SavedFile struct {
+	Name                string `yaml:"name"`
+	Whole               bool   `yaml:"whole"`
+	IncludedLineIndices []int  `yaml:"includedLineIndices"`
+}
+
+var patchFileNameRegexp = regexp.MustCompile(`(?m)^diff --git a/.* b/(.*)$`)
+
+// Save returns the current state of the patch so that it can be persisted.
+func (p *PatchManager) Save() *SavedPatch {
+	filenames := maps.Keys(p.fileInfoMap)
+	sort.Strings(filenames)
+
+	files := []SavedFile{}
+	for _, filename := range filenames {
+		info := p.fileInfoMap[filename]
+		if info.mode == UNSELECTED {
+			continue
+		}
+
+		files = append(files, SavedFile{
+			Name:                filename,
+			Whole:               info.mode == WHOLE,
+			IncludedLineIndices: info.includedLineIndices,
+		})
+	}
+
+	return &SavedPatch{
+		From:      p.From,
+		To:        p.To,
+		Reverse:   p.reverse,
+		CanRebase: p.CanRebase,
+		Files:     files,
+	}
+}
+
+// Restore starts a new patch from a previously saved one, reloading each file's diff.
+func (p *PatchManager) Restore(saved *SavedPatch) error {
+	p.Start(saved.From, saved.To, saved.Reverse, saved.CanRebase)
+
+	for _, file := range saved.Files {
+		info, err := p.getFileInfo(file.Name)
+		if err != nil {
+			p.Reset()
+			return err
+		}
+
+		if file.Whole {
+			p.addFileWhole(info)
+		} else if len(file.IncludedLineIndices) > 0 {
+			info.mode = PART
+			info.includedLineIndices = file.IncludedLineIndices
+		}
+	}
+
+	return nil
+}
+
+// AddPatch takes a patch (e.g. one loaded from a .patch file) and selects the
+// corresponding lines of each file's diff, so that the resulting custom patch is
+// equivalent to the given one. The patch must have been made against the same base
+// as the current patch, but it may contain only a subset of the changes.
+func (p *PatchManager) AddPatch(patch string) error {
+	filePatches := splitPatchByFile(patch)
+	if len(filePatches) == 0 {
+		return fmt.Errorf("no file diffs found in patch")
+	}
+
+	for _, filePatch := range filePatches {
+		info, err := p.getFileInfo(filePatch.filename)
+		if err != nil {
+			return err
+		}
+
+		lineIndices, err := includedLineIndicesForPatch(info.diff, filePatch.patch)
+		if err != nil {
+			return fmt.Errorf("%s: %w", filePatch.filename, err)
+		}
+
+		if len(lineIndices) == countChangedLines(info.diff) {
+			p.addFileWhole(info)
+		} else if len(lineIndices) > 0 {
+			info.mode = PART
+			info.includedLineIndices = lineIndices
+		}
+	}
+
+	return nil
+}
+
+type filePatch struct {
+	filename string
+	patch    string
+}
+
+func splitPatchByFile(patch string) []filePatch {
+	result := []filePatch{}
+	locations := patchFileNameRegexp.FindAllStringSubmatchIndex(patch, -1)
+	for i, location := range locations {
+		end := len(patch)
+		if i < len(locations)-1 {
+			end = locations[i+1][0]
+		}
+
+		result = append(result, filePatch{
+			filename: patch[location[2]:location[3]],
+			patch:    patch[location[0]:end],
+		})
+	}
+
+	return result
+}
+
+// a changed line is identified by its content and its position in the old version
+// of the file. The old side of a partial patch is the same as that of the full diff,
+// so this lets us match the lines of a partial patch against the full diff.
+type changedLineKey struct {
+	content string
+	oldPos  int
+}
+
+func changedLines(diff string, f func(key changedLineKey, lineIdx int)) {
+	for _, hunk := range GetHunksFromDiff(diff) {
+		oldPos := hunk.oldStart
+		for i, line := range hunk.bodyLines {
+			line = strings.TrimSuffix(line, "\n")
+			if line == "" {
+				break
+			}
+
+			switch line[:1] {
+			case "-":
+				f(changedLineKey{content: line, oldPos: oldPos}, hunk.FirstLineIdx+1+i)
+				oldPos++
+			case "+":
+				f(changedLineKey{content: line, oldPos: oldPos}, hunk.FirstLineIdx+1+i)
+			case " ":
+				oldPos++
+			}
+		}
+	}
+}
+
+func countChangedLines(diff string) int {
+	count := 0
+	changedLines(diff, func(changedLineKey, int) { count++ })
+	return count
+}
+
+func includedLineIndicesForPatch(diff string, patch string) ([]int, error) {
+	available := map[changedLineKey][]int{}
+	changedLines(diff, func(key changedLineKey, lineIdx int) {
+		available[key] = append(available[key], lineIdx)
+	})
+
+	lineIndices := []int{}
+	var err error
+	changedLines(patch, func(key changedLineKey, _ int) {
+		if err != nil {
+			return
+		}
+
+		candidates := available[key]
+		if len(candidates) == 0 {
+			err = fmt.Errorf("line '%s' does not match the diff", key.content)
+			return
+		}
+
+		lineIndices = append(lineIndices, candidates[0])
+		available[key] = candidates[1:]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Ints(lineIndices)
+	return lineIndices, nil
+}
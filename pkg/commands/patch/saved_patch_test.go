@@ -0,0 +1,107 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPatchManager(diffs map[string]string) *PatchManager {
+	loadFileDiff := func(from string, to string, reverse bool, filename string, plain bool) (string, error) {
+		return diffs[filename], nil
+	}
+	applyPatch := func(patch string, flags ...string) error { return nil }
+
+	return NewPatchManager(utils.NewDummyLog(), applyPatch, loadFileDiff)
+}
+
+func TestAddPatch(t *testing.T) {
+	type scenario struct {
+		testName            string
+		patch               func() string
+		expectedStatus      PatchStatus
+		expectedLineIndices []int
+		expectedErr         bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:            "whole file",
+			patch:               func() string { return twoHunks },
+			expectedStatus:      WHOLE,
+			expectedLineIndices: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+		},
+		{
+			testName: "some lines from each hunk",
+			patch: func() string {
+				return ModifiedPatchForLines(utils.NewDummyLog(), "filename", twoHunks, []int{7, 16}, false, true)
+			},
+			expectedStatus:      PART,
+			expectedLineIndices: []int{7, 16},
+		},
+		{
+			testName: "deletion without its addition",
+			patch: func() string {
+				return ModifiedPatchForLines(utils.NewDummyLog(), "filename", twoHunks, []int{6}, false, true)
+			},
+			expectedStatus:      PART,
+			expectedLineIndices: []int{6},
+		},
+		{
+			testName:       "patch against a different commit",
+			patch:          func() string { return simpleDiff },
+			expectedStatus: UNSELECTED,
+			expectedErr:    true,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			p := newTestPatchManager(map[string]string{"filename": twoHunks})
+			p.Start("from", "to", false, true)
+
+			err := p.AddPatch(s.patch())
+			if s.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, s.expectedStatus, p.GetFileStatus("filename", "to"))
+			if s.expectedLineIndices != nil {
+				lineIndices, err := p.GetFileIncLineIndices("filename")
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedLineIndices, lineIndices)
+			}
+		})
+	}
+}
+
+func TestSaveAndRestore(t *testing.T) {
+	diffs := map[string]string{"filename": twoHunks, "newfile": newFile}
+
+	p := newTestPatchManager(diffs)
+	p.Start("abc^", "abc", false, true)
+	assert.NoError(t, p.AddFileLineRange("filename", 15, 16))
+	assert.NoError(t, p.AddFileWhole("newfile"))
+
+	saved := p.Save()
+	assert.Equal(t, &SavedPatch{
+		From:      "abc^",
+		To:        "abc",
+		Reverse:   false,
+		CanRebase: true,
+		Files: []SavedFile{
+			{Name: "filename", Whole: false, IncludedLineIndices: []int{15, 16}},
+			{Name: "newfile", Whole: true, IncludedLineIndices: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		},
+	}, saved)
+
+	restored := newTestPatchManager(diffs)
+	assert.NoError(t, restored.Restore(saved))
+	assert.Equal(t, p.RenderAggregatedPatchColored(true), restored.RenderAggregatedPatchColored(true))
+	assert.Equal(t, "abc", restored.To)
+	assert.True(t, restored.CanRebase)
+}
@@ -0,0 +1,373 @@
+package patch
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/mattn/go-runewidth"
+	"github.com/samber/lo"
+)
+
+// Here we lay out a diff in two columns, with the old version of the file on the
+// left and the new version on the right. Context lines show on both sides, and
+// each run of removed lines shows next to the run of added lines that follows it.
+// Any other line (e.g. a header) spans both columns. Lines too long for their
+// column wrap onto more lines of the view.
+
+// below this width we don't have room for two columns
+const minSideBySideWidth = 20
+
+const sideBySideSeparator = "│"
+
+type sideBySideCell struct {
+	patchLineIdx int
+	kind         PatchLineKind
+	content      string // without the leading '+', '-' or ' ', and with tabs expanded
+	changedSpans []span
+	syntaxTokens []syntaxToken
+	selected     bool
+	included     bool
+}
+
+type sideBySideRow struct {
+	// either side can be nil, e.g. when more lines were added than removed
+	left  *sideBySideCell
+	right *sideBySideCell
+	// rows that aren't a hunk's content span both columns and are already rendered
+	fullWidth    string
+	patchLineIdx int
+}
+
+func (row *sideBySideRow) isFullWidth() bool {
+	return row.left == nil && row.right == nil
+}
+
+// the patch line we take the user to mean when they click on the row
+func (row *sideBySideRow) clickedPatchLineIdx() int {
+	switch {
+	case row.left != nil:
+		return row.left.patchLineIdx
+	case row.right != nil:
+		return row.right.patchLineIdx
+	default:
+		return row.patchLineIdx
+	}
+}
+
+func expandTabs(str string) string {
+	return strings.ReplaceAll(str, "\t", "    ")
+}
+
+// sideBySideRowsForBlock pairs up a run of removed lines with the run of added
+// lines that follows it. The cells have their patchLineIdx and selection set by
+// the caller. The highlighter is nil if we're not highlighting syntax.
+func sideBySideRowsForBlock(deletions []string, additions []string, wordDiff string, highlighter *diffHighlighter) []*sideBySideRow {
+	deletions = slices.Map(deletions, expandTabs)
+	additions = slices.Map(additions, expandTabs)
+	deletionSpans, additionSpans := changedSpansForBlock(deletions, additions, wordDiff)
+	deletionTokens := highlightAll(highlighter, DELETION, deletions)
+	additionTokens := highlightAll(highlighter, ADDITION, additions)
+
+	rows := make([]*sideBySideRow, utils.Max(len(deletions), len(additions)))
+	for i := range rows {
+		rows[i] = &sideBySideRow{}
+		if i < len(deletions) {
+			rows[i].left = &sideBySideCell{kind: DELETION, content: deletions[i], changedSpans: deletionSpans[i], syntaxTokens: deletionTokens[i]}
+		}
+		if i < len(additions) {
+			rows[i].right = &sideBySideCell{kind: ADDITION, content: additions[i], changedSpans: additionSpans[i], syntaxTokens: additionTokens[i]}
+		}
+	}
+
+	return rows
+}
+
+func contextRow(content string, highlighter *diffHighlighter) *sideBySideRow {
+	content = expandTabs(content)
+	var syntaxTokens []syntaxToken
+	if highlighter != nil {
+		syntaxTokens = highlighter.highlight(CONTEXT, content)
+	}
+
+	return &sideBySideRow{
+		left:  &sideBySideCell{kind: CONTEXT, content: content, syntaxTokens: syntaxTokens},
+		right: &sideBySideCell{kind: CONTEXT, content: content, syntaxTokens: syntaxTokens},
+	}
+}
+
+// highlightAll returns the syntax tokens of each of the lines, all of which are of
+// the given kind
+func highlightAll(highlighter *diffHighlighter, kind PatchLineKind, lines []string) [][]syntaxToken {
+	result := make([][]syntaxToken, len(lines))
+	if highlighter != nil {
+		for i, line := range lines {
+			result[i] = highlighter.highlight(kind, line)
+		}
+	}
+
+	return result
+}
+
+// columnWidthFor returns the width of each column when the view is the given
+// width, leaving room for the separator
+func columnWidthFor(width int) int {
+	return (width - runewidth.StringWidth(sideBySideSeparator)) / 2
+}
+
+// render returns the lines of the view that the row takes up
+func (row *sideBySideRow) render(columnWidth int) []string {
+	if row.isFullWidth() {
+		return []string{row.fullWidth}
+	}
+
+	left := row.left.render(columnWidth)
+	right := row.right.render(columnWidth)
+	separator := style.FgBlue.Sprint(sideBySideSeparator)
+	lines := make([]string, utils.Max(len(left), len(right)))
+	for i := range lines {
+		lines[i] = cellLine(left, i, columnWidth) + separator + cellLine(right, i, columnWidth)
+	}
+
+	return lines
+}
+
+func cellLine(cellLines []string, i int, columnWidth int) string {
+	if i < len(cellLines) {
+		return cellLines[i]
+	}
+
+	return strings.Repeat(" ", columnWidth)
+}
+
+// render returns the cell's content wrapped to the column width, with the '+' or
+// '-' in the first column. A missing cell renders as one blank line.
+func (cell *sideBySideCell) render(columnWidth int) []string {
+	if cell == nil {
+		return []string{strings.Repeat(" ", columnWidth)}
+	}
+
+	var textStyle style.TextStyle
+	prefix := " "
+	switch cell.kind {
+	case ADDITION:
+		textStyle = style.FgGreen
+		prefix = "+"
+	case DELETION:
+		textStyle = style.FgRed
+		prefix = "-"
+	default:
+		textStyle = theme.DefaultTextColor
+	}
+	if cell.selected {
+		textStyle = textStyle.MergeStyle(theme.SelectedRangeBgColor)
+	}
+	prefixStyle := textStyle
+	if cell.included {
+		prefixStyle = prefixStyle.MergeStyle(style.BgGreen)
+	}
+
+	textWidth := utils.Max(columnWidth-1, 1)
+	chunks := wrapByWidth(cell.content, textWidth)
+	lines := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		linePrefix := " "
+		if i == 0 {
+			linePrefix = prefix
+		}
+		text := cell.content[chunk.start:chunk.end]
+		padding := strings.Repeat(" ", utils.Max(textWidth-runewidth.StringWidth(text), 0))
+		lines[i] = prefixStyle.Sprint(linePrefix) + styleLine(textStyle, text, tokensWithin(cell.syntaxTokens, chunk), spansWithin(cell.changedSpans, chunk)) + textStyle.Sprint(padding)
+	}
+
+	return lines
+}
+
+// wrapByWidth splits str into spans that each fit in the given width
+func wrapByWidth(str string, width int) []span {
+	chunks := []span{}
+	start := 0
+	chunkWidth := 0
+	for i, r := range str {
+		runeWidth := runewidth.RuneWidth(r)
+		if chunkWidth+runeWidth > width && i > start {
+			chunks = append(chunks, span{start: start, end: i})
+			start = i
+			chunkWidth = 0
+		}
+		chunkWidth += runeWidth
+	}
+
+	return append(chunks, span{start: start, end: len(str)})
+}
+
+// spansWithin returns the parts of the spans that fall within the chunk,
+// relative to the start of the chunk
+func spansWithin(spans []span, chunk span) []span {
+	result := []span{}
+	for _, s := range spans {
+		start := utils.Max(s.start, chunk.start)
+		end := utils.Min(s.end, chunk.end)
+		if start < end {
+			result = append(result, span{start: start - chunk.start, end: end - chunk.start})
+		}
+	}
+
+	return result
+}
+
+// tokensWithin is like spansWithin but for syntax tokens
+func tokensWithin(tokens []syntaxToken, chunk span) []syntaxToken {
+	result := []syntaxToken{}
+	for _, token := range tokens {
+		for _, s := range spansWithin([]span{token.span}, chunk) {
+			result = append(result, syntaxToken{span: s, kind: token.kind})
+		}
+	}
+
+	return result
+}
+
+// SideBySideView is a patch laid out side by side, along with where each of the
+// patch's lines ended up in the view, so that we can still select lines of the
+// patch in order to stage them
+type SideBySideView struct {
+	Content string
+	// the first and last view lines of the row that each patch line is in
+	firstViewLineIdx []int
+	lastViewLineIdx  []int
+	// the patch line we take each view line to be
+	patchLineIdx []int
+}
+
+// RenderSideBySide is like Render but lays the patch out side by side in the
+// given width. It returns nil if the width is too small for that.
+func (p *PatchParser) RenderSideBySide(width int, isFocused bool, firstLineIndex int, lastLineIndex int, incLineIndices []int, wordDiff string, syntaxHighlighting bool) *SideBySideView {
+	if width < minSideBySideWidth {
+		return nil
+	}
+
+	view := &SideBySideView{
+		firstViewLineIdx: make([]int, len(p.PatchLines)),
+		lastViewLineIdx:  make([]int, len(p.PatchLines)),
+	}
+
+	isSelected := func(index int) bool {
+		return isFocused && index >= firstLineIndex && index <= lastLineIndex
+	}
+	isIncluded := func(index int) bool {
+		return lo.Contains(incLineIndices, index)
+	}
+
+	var highlighter *diffHighlighter
+	if syntaxHighlighting {
+		highlighter = &diffHighlighter{}
+	}
+
+	columnWidth := columnWidthFor(width)
+	lines := []string{}
+	addRow := func(row *sideBySideRow) {
+		rowLines := row.render(columnWidth)
+		for _, cell := range []*sideBySideCell{row.left, row.right} {
+			if cell != nil {
+				view.firstViewLineIdx[cell.patchLineIdx] = len(lines)
+				view.lastViewLineIdx[cell.patchLineIdx] = len(lines) + len(rowLines) - 1
+			}
+		}
+		if row.isFullWidth() {
+			view.firstViewLineIdx[row.patchLineIdx] = len(lines)
+			view.lastViewLineIdx[row.patchLineIdx] = len(lines)
+		}
+		for range rowLines {
+			view.patchLineIdx = append(view.patchLineIdx, row.clickedPatchLineIdx())
+		}
+		lines = append(lines, rowLines...)
+	}
+
+	lineContent := func(line *PatchLine) string {
+		if line.Content == "" {
+			return ""
+		}
+		return line.Content[1:]
+	}
+	setCell := func(cell *sideBySideCell, index int) {
+		if cell != nil {
+			cell.patchLineIdx = index
+			cell.selected = isSelected(index)
+			cell.included = isIncluded(index)
+		}
+	}
+
+	for i := 0; i < len(p.PatchLines); {
+		line := p.PatchLines[i]
+		switch line.Kind {
+		case CONTEXT:
+			row := contextRow(lineContent(line), highlighter)
+			setCell(row.left, i)
+			setCell(row.right, i)
+			addRow(row)
+			i++
+		case DELETION, ADDITION:
+			deletionsStart := i
+			for i < len(p.PatchLines) && p.PatchLines[i].Kind == DELETION {
+				i++
+			}
+			additionsStart := i
+			for i < len(p.PatchLines) && p.PatchLines[i].Kind == ADDITION {
+				i++
+			}
+
+			deletions := slices.Map(p.PatchLines[deletionsStart:additionsStart], lineContent)
+			additions := slices.Map(p.PatchLines[additionsStart:i], lineContent)
+			for j, row := range sideBySideRowsForBlock(deletions, additions, wordDiff, highlighter) {
+				setCell(row.left, deletionsStart+j)
+				setCell(row.right, additionsStart+j)
+				addRow(row)
+			}
+		default:
+			if highlighter != nil && line.Kind == HUNK_HEADER {
+				highlighter.hunkStart()
+			} else if highlighter != nil && line.Kind == PATCH_HEADER {
+				highlighter.headerLine(line.Content)
+			}
+			addRow(&sideBySideRow{
+				fullWidth:    line.render(isSelected(i), isIncluded(i), nil, nil),
+				patchLineIdx: i,
+			})
+			i++
+		}
+	}
+
+	view.Content = strings.Join(lines, "\n")
+
+	return view
+}
+
+// ViewLineRange returns the first and last lines of the view taken up by the given
+// range of patch lines
+func (self *SideBySideView) ViewLineRange(firstLineIdx int, lastLineIdx int) (int, int) {
+	// with the removed lines next to the added lines, the range's first and last
+	// patch lines aren't necessarily the highest and lowest in the view
+	first := self.firstViewLineIdx[firstLineIdx]
+	last := self.lastViewLineIdx[firstLineIdx]
+	for i := firstLineIdx + 1; i <= lastLineIdx; i++ {
+		first = utils.Min(first, self.firstViewLineIdx[i])
+		last = utils.Max(last, self.lastViewLineIdx[i])
+	}
+
+	return first, last
+}
+
+func (self *SideBySideView) ViewLineIdx(patchLineIdx int) int {
+	return self.firstViewLineIdx[patchLineIdx]
+}
+
+func (self *SideBySideView) PatchLineIdx(viewLineIdx int) int {
+	if len(self.patchLineIdx) == 0 {
+		return 0
+	}
+
+	return self.patchLineIdx[utils.Clamp(viewLineIdx, 0, len(self.patchLineIdx)-1)]
+}
@@ -0,0 +1,55 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
+
+func TestRenderSideBySide(t *testing.T) {
+	color.ForceSetColorLevel(terminfo.ColorLevelNone)
+
+	parser := NewPatchParser(nil, wordDiffPatch)
+	view := parser.RenderSideBySide(24, true, 8, 9, nil, WORD_DIFF_OFF, false)
+
+	expected := []string{
+		"diff --git a/file.go b/file.go",
+		"index 1234567..89abcde 100644",
+		"--- a/file.go",
+		"+++ b/file.go",
+		"@@ -1,5 +1,5 @@",
+		" package ma│ package ma",
+		" in        │ in        ",
+		"-var a = 1 │+var a = 10",
+		"-var b = 2 │+var c = 2 ",
+		"           │+var d = 3 ",
+		" func main(│ func main(",
+		" ) {}      │ ) {}      ",
+		"           │           ",
+	}
+	assert.Equal(t, expected, strings.Split(view.Content, "\n"))
+
+	// the added lines are next to the removed lines
+	first, last := view.ViewLineRange(6, 10)
+	assert.Equal(t, 7, first)
+	assert.Equal(t, 9, last)
+	assert.Equal(t, 8, view.ViewLineIdx(9))
+
+	// a wrapped line takes up all of its view lines
+	first, last = view.ViewLineRange(5, 5)
+	assert.Equal(t, 5, first)
+	assert.Equal(t, 6, last)
+
+	// clicking a row selects the removed line rather than the added line
+	assert.Equal(t, 7, view.PatchLineIdx(8))
+	assert.Equal(t, 10, view.PatchLineIdx(9))
+	assert.Equal(t, 5, view.PatchLineIdx(6))
+}
+
+func TestRenderSideBySideTooNarrow(t *testing.T) {
+	parser := NewPatchParser(nil, wordDiffPatch)
+	assert.Nil(t, parser.RenderSideBySide(10, true, 8, 9, nil, WORD_DIFF_OFF, false))
+}
@@ -0,0 +1,406 @@
+package patch
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Here we colour the content of a diff's lines by the syntax of the file's
+// language, which we go by the file's extension to find. We only pick out
+// keywords, strings, comments and numbers, which is about as far as we can go
+// without parsing the whole file, given that a diff only shows parts of it.
+// The colours are layered over the colour of the line, so added lines are still
+// green where there's nothing to highlight.
+
+// we don't highlight lines longer than this, so that e.g. minified files don't
+// slow down rendering
+const maxSyntaxHighlightLineLength = 1000
+
+type syntaxKind int
+
+const (
+	SYNTAX_KEYWORD syntaxKind = iota
+	SYNTAX_STRING
+	SYNTAX_COMMENT
+	SYNTAX_NUMBER
+)
+
+func (kind syntaxKind) textStyle() style.TextStyle {
+	switch kind {
+	case SYNTAX_KEYWORD:
+		return style.FgMagenta
+	case SYNTAX_STRING:
+		return style.FgYellow
+	case SYNTAX_COMMENT:
+		return style.FgBlue
+	default:
+		return style.FgCyan
+	}
+}
+
+// syntaxToken is a span of a line to colour as the given kind of syntax
+type syntaxToken struct {
+	span
+	kind syntaxKind
+}
+
+type language struct {
+	keywords      map[string]bool
+	lineComments  []string
+	blockComment  [2]string // the start and end of a block comment, if the language has them
+	stringQuotes  string
+	caseSensitive bool
+}
+
+var (
+	cLikeBlockComment = [2]string{"/*", "*/"}
+
+	goLanguage = &language{
+		keywords:      keywordSet("break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "false", "for", "func", "go", "goto", "if", "import", "interface", "iota", "map", "nil", "package", "range", "return", "select", "struct", "switch", "true", "type", "var"),
+		lineComments:  []string{"//"},
+		blockComment:  cLikeBlockComment,
+		stringQuotes:  "\"'`",
+		caseSensitive: true,
+	}
+
+	javascriptLanguage = &language{
+		keywords:      keywordSet("async", "await", "break", "case", "catch", "class", "const", "continue", "default", "delete", "do", "else", "export", "extends", "false", "finally", "for", "from", "function", "if", "import", "in", "instanceof", "interface", "let", "new", "null", "of", "return", "static", "super", "switch", "this", "throw", "true", "try", "type", "typeof", "undefined", "var", "void", "while", "yield"),
+		lineComments:  []string{"//"},
+		blockComment:  cLikeBlockComment,
+		stringQuotes:  "\"'`",
+		caseSensitive: true,
+	}
+
+	pythonLanguage = &language{
+		keywords:      keywordSet("False", "None", "True", "and", "as", "assert", "async", "await", "break", "class", "continue", "def", "del", "elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in", "is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return", "self", "try", "while", "with", "yield"),
+		lineComments:  []string{"#"},
+		stringQuotes:  "\"'",
+		caseSensitive: true,
+	}
+
+	rubyLanguage = &language{
+		keywords:      keywordSet("alias", "and", "begin", "break", "case", "class", "def", "defined", "do", "else", "elsif", "end", "ensure", "false", "for", "if", "in", "module", "next", "nil", "not", "or", "redo", "rescue", "retry", "return", "self", "super", "then", "true", "undef", "unless", "until", "when", "while", "yield"),
+		lineComments:  []string{"#"},
+		stringQuotes:  "\"'",
+		caseSensitive: true,
+	}
+
+	rustLanguage = &language{
+		keywords:      keywordSet("as", "async", "await", "break", "const", "continue", "crate", "dyn", "else", "enum", "extern", "false", "fn", "for", "if", "impl", "in", "let", "loop", "match", "mod", "move", "mut", "pub", "ref", "return", "self", "Self", "static", "struct", "super", "trait", "true", "type", "unsafe", "use", "where", "while"),
+		lineComments:  []string{"//"},
+		blockComment:  cLikeBlockComment,
+		stringQuotes:  "\"",
+		caseSensitive: true,
+	}
+
+	cLanguage = &language{
+		keywords:      keywordSet("auto", "bool", "break", "case", "catch", "char", "class", "const", "continue", "default", "delete", "do", "double", "else", "enum", "extern", "false", "float", "for", "goto", "if", "inline", "int", "long", "namespace", "new", "nullptr", "private", "protected", "public", "register", "return", "short", "signed", "sizeof", "static", "struct", "switch", "template", "this", "throw", "true", "try", "typedef", "union", "unsigned", "using", "virtual", "void", "volatile", "while", "#define", "#endif", "#if", "#ifdef", "#ifndef", "#include"),
+		lineComments:  []string{"//"},
+		blockComment:  cLikeBlockComment,
+		stringQuotes:  "\"'",
+		caseSensitive: true,
+	}
+
+	javaLanguage = &language{
+		keywords:      keywordSet("abstract", "boolean", "break", "case", "catch", "class", "const", "continue", "data", "default", "do", "double", "else", "enum", "extends", "false", "final", "finally", "float", "for", "fun", "if", "implements", "import", "instanceof", "int", "interface", "long", "namespace", "new", "null", "object", "override", "package", "private", "protected", "public", "return", "static", "string", "super", "switch", "this", "throw", "throws", "true", "try", "using", "val", "var", "void", "when", "while"),
+		lineComments:  []string{"//"},
+		blockComment:  cLikeBlockComment,
+		stringQuotes:  "\"'",
+		caseSensitive: true,
+	}
+
+	shellLanguage = &language{
+		keywords:      keywordSet("case", "do", "done", "elif", "else", "esac", "exit", "export", "fi", "for", "function", "if", "in", "local", "return", "then", "until", "while"),
+		lineComments:  []string{"#"},
+		stringQuotes:  "\"'",
+		caseSensitive: true,
+	}
+
+	// for config files like YAML and TOML
+	hashCommentLanguage = &language{
+		keywords:      keywordSet("false", "null", "true"),
+		lineComments:  []string{"#"},
+		stringQuotes:  "\"'",
+		caseSensitive: true,
+	}
+
+	jsonLanguage = &language{
+		keywords:      keywordSet("false", "null", "true"),
+		stringQuotes:  "\"",
+		caseSensitive: true,
+	}
+
+	sqlLanguage = &language{
+		keywords:      keywordSet("alter", "and", "as", "by", "create", "delete", "drop", "from", "group", "in", "index", "insert", "into", "is", "join", "key", "left", "not", "null", "on", "or", "order", "primary", "select", "set", "table", "update", "values", "where"),
+		lineComments:  []string{"--"},
+		blockComment:  cLikeBlockComment,
+		stringQuotes:  "'\"",
+		caseSensitive: false,
+	}
+
+	languagesByExtension = map[string]*language{
+		".go":   goLanguage,
+		".js":   javascriptLanguage,
+		".jsx":  javascriptLanguage,
+		".mjs":  javascriptLanguage,
+		".cjs":  javascriptLanguage,
+		".ts":   javascriptLanguage,
+		".tsx":  javascriptLanguage,
+		".py":   pythonLanguage,
+		".rb":   rubyLanguage,
+		".rs":   rustLanguage,
+		".c":    cLanguage,
+		".h":    cLanguage,
+		".cc":   cLanguage,
+		".cpp":  cLanguage,
+		".cxx":  cLanguage,
+		".hpp":  cLanguage,
+		".java": javaLanguage,
+		".kt":   javaLanguage,
+		".cs":   javaLanguage,
+		".sh":   shellLanguage,
+		".bash": shellLanguage,
+		".zsh":  shellLanguage,
+		".yml":  hashCommentLanguage,
+		".yaml": hashCommentLanguage,
+		".toml": hashCommentLanguage,
+		".json": jsonLanguage,
+		".sql":  sqlLanguage,
+	}
+
+	languagesByFilename = map[string]*language{
+		"Makefile":   shellLanguage,
+		"Dockerfile": shellLanguage,
+	}
+)
+
+// languageForPath returns the language of the file at the given path, or nil if
+// we don't know it
+func languageForPath(path string) *language {
+	name := filepath.Base(path)
+	if language, ok := languagesByFilename[name]; ok {
+		return language
+	}
+
+	return languagesByExtension[strings.ToLower(filepath.Ext(name))]
+}
+
+func keywordSet(keywords ...string) map[string]bool {
+	result := make(map[string]bool, len(keywords))
+	for _, keyword := range keywords {
+		result[keyword] = true
+	}
+
+	return result
+}
+
+func (self *language) isKeyword(word string) bool {
+	if !self.caseSensitive {
+		word = strings.ToLower(word)
+	}
+
+	return self.keywords[word]
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b >= 0x80 ||
+		(b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// highlight returns the tokens of the line to colour. inBlockComment says whether
+// the line starts inside a block comment, and is updated to whether the next
+// line does.
+func (self *language) highlight(line string, inBlockComment *bool) []syntaxToken {
+	tokens := []syntaxToken{}
+	addToken := func(start int, end int, kind syntaxKind) {
+		tokens = append(tokens, syntaxToken{span: span{start: start, end: end}, kind: kind})
+	}
+
+	i := 0
+	if *inBlockComment {
+		end := strings.Index(line, self.blockComment[1])
+		if end == -1 {
+			addToken(0, len(line), SYNTAX_COMMENT)
+			return tokens
+		}
+		i = end + len(self.blockComment[1])
+		addToken(0, i, SYNTAX_COMMENT)
+		*inBlockComment = false
+	}
+
+	for i < len(line) {
+		rest := line[i:]
+
+		if self.blockComment[0] != "" && strings.HasPrefix(rest, self.blockComment[0]) {
+			end := strings.Index(rest[len(self.blockComment[0]):], self.blockComment[1])
+			if end == -1 {
+				addToken(i, len(line), SYNTAX_COMMENT)
+				*inBlockComment = true
+				return tokens
+			}
+			end += i + len(self.blockComment[0]) + len(self.blockComment[1])
+			addToken(i, end, SYNTAX_COMMENT)
+			i = end
+			continue
+		}
+
+		for _, lineComment := range self.lineComments {
+			if strings.HasPrefix(rest, lineComment) {
+				addToken(i, len(line), SYNTAX_COMMENT)
+				return tokens
+			}
+		}
+
+		b := line[i]
+		switch {
+		case strings.IndexByte(self.stringQuotes, b) != -1:
+			end := i + 1
+			for end < len(line) && line[end] != b {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end = utils.Min(end+1, len(line))
+			addToken(i, end, SYNTAX_STRING)
+			i = end
+		case isDigit(b):
+			end := i + 1
+			for end < len(line) && (isWordByte(line[end]) || line[end] == '.') {
+				end++
+			}
+			addToken(i, end, SYNTAX_NUMBER)
+			i = end
+		case isWordByte(b) || b == '#':
+			end := i + 1
+			for end < len(line) && isWordByte(line[end]) {
+				end++
+			}
+			if self.isKeyword(line[i:end]) {
+				addToken(i, end, SYNTAX_KEYWORD)
+			}
+			i = end
+		default:
+			i++
+		}
+	}
+
+	return tokens
+}
+
+// diffHighlighter highlights the content lines of a diff as they come, keeping
+// track of which file they belong to and, for each of the old and new versions
+// of the file, whether we're in a block comment
+type diffHighlighter struct {
+	language *language
+	oldPath  string
+
+	oldInBlockComment bool
+	newInBlockComment bool
+}
+
+// headerLine tells the highlighter about a line that isn't part of a hunk, so
+// that it can pick up on the file names
+func (self *diffHighlighter) headerLine(line string) {
+	path := ""
+	switch {
+	case strings.HasPrefix(line, "--- "):
+		self.oldPath = diffHeaderPath(line)
+		path = self.oldPath
+	case strings.HasPrefix(line, "+++ "):
+		path = diffHeaderPath(line)
+		if path == "/dev/null" {
+			path = self.oldPath
+		}
+	default:
+		return
+	}
+
+	self.language = languageForPath(path)
+}
+
+func diffHeaderPath(line string) string {
+	// git adds a trailing tab when the path contains spaces, and quotes paths
+	// with unusual characters
+	return strings.Trim(line[len("+++ "):], "\t\"")
+}
+
+// hunkStart tells the highlighter that a new hunk starts. We don't know what
+// comes before a hunk so we just assume it's not in a block comment.
+func (self *diffHighlighter) hunkStart() {
+	self.oldInBlockComment = false
+	self.newInBlockComment = false
+}
+
+// highlight returns the tokens of the content of a line of a hunk, given without
+// its leading '+', '-' or ' '
+func (self *diffHighlighter) highlight(kind PatchLineKind, content string) []syntaxToken {
+	if self.language == nil || len(content) > maxSyntaxHighlightLineLength {
+		return nil
+	}
+
+	switch kind {
+	case DELETION:
+		return self.language.highlight(content, &self.oldInBlockComment)
+	case ADDITION:
+		return self.language.highlight(content, &self.newInBlockComment)
+	case CONTEXT:
+		self.language.highlight(content, &self.oldInBlockComment)
+		return self.language.highlight(content, &self.newInBlockComment)
+	default:
+		return nil
+	}
+}
+
+// styleLine renders str in the given style, with the colours of the syntax
+// tokens layered over it and the changed spans (see word_diff.go) emphasised
+func styleLine(textStyle style.TextStyle, str string, tokens []syntaxToken, changedSpans []span) string {
+	if len(tokens) == 0 {
+		return emphasise(textStyle, str, changedSpans)
+	}
+
+	// the points at which the style can change
+	boundaries := []int{0, len(str)}
+	for _, token := range tokens {
+		boundaries = append(boundaries, token.start, token.end)
+	}
+	for _, s := range changedSpans {
+		boundaries = append(boundaries, s.start, s.end)
+	}
+	sort.Ints(boundaries)
+
+	styleAt := func(offset int) style.TextStyle {
+		result := textStyle
+		for _, token := range tokens {
+			if offset >= token.start && offset < token.end {
+				result = result.MergeStyle(token.kind.textStyle())
+				break
+			}
+		}
+		for _, s := range changedSpans {
+			if offset >= s.start && offset < s.end {
+				result = result.SetReverse()
+				break
+			}
+		}
+		return result
+	}
+
+	result := ""
+	for i := 1; i < len(boundaries); i++ {
+		start, end := boundaries[i-1], boundaries[i]
+		if start < end && end <= len(str) {
+			result += styleAt(start).Sprint(str[start:end])
+		}
+	}
+
+	return result
+}
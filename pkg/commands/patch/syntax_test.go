@@ -0,0 +1,135 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguageHighlight(t *testing.T) {
+	type scenario struct {
+		testName               string
+		language               *language
+		line                   string
+		inBlockComment         bool
+		expected               []syntaxToken
+		expectedInBlockComment bool
+	}
+
+	token := func(start int, end int, kind syntaxKind) syntaxToken {
+		return syntaxToken{span: span{start: start, end: end}, kind: kind}
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "keywords, strings and numbers",
+			language: goLanguage,
+			line:     `return fmt.Sprintf("%d\"", 42)`,
+			expected: []syntaxToken{
+				token(0, 6, SYNTAX_KEYWORD),
+				token(19, 25, SYNTAX_STRING),
+				token(27, 29, SYNTAX_NUMBER),
+			},
+		},
+		{
+			testName: "digits within a word aren't a number",
+			language: goLanguage,
+			line:     "var x2 = y3",
+			expected: []syntaxToken{token(0, 3, SYNTAX_KEYWORD)},
+		},
+		{
+			testName: "line comment",
+			language: pythonLanguage,
+			line:     "pass  # not yet",
+			expected: []syntaxToken{
+				token(0, 4, SYNTAX_KEYWORD),
+				token(6, 15, SYNTAX_COMMENT),
+			},
+		},
+		{
+			testName:               "block comment starting",
+			language:               goLanguage,
+			line:                   "x /* the",
+			expected:               []syntaxToken{token(2, 8, SYNTAX_COMMENT)},
+			expectedInBlockComment: true,
+		},
+		{
+			testName:       "block comment ending",
+			language:       goLanguage,
+			line:           "end */ if",
+			inBlockComment: true,
+			expected: []syntaxToken{
+				token(0, 6, SYNTAX_COMMENT),
+				token(7, 9, SYNTAX_KEYWORD),
+			},
+		},
+		{
+			testName:       "within a block comment",
+			language:       goLanguage,
+			line:           "return",
+			inBlockComment: true,
+			expected:       []syntaxToken{token(0, 6, SYNTAX_COMMENT)},
+
+			expectedInBlockComment: true,
+		},
+		{
+			testName: "case insensitive keywords",
+			language: sqlLanguage,
+			line:     "SELECT a",
+			expected: []syntaxToken{token(0, 6, SYNTAX_KEYWORD)},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			inBlockComment := s.inBlockComment
+			assert.Equal(t, s.expected, s.language.highlight(s.line, &inBlockComment))
+			assert.Equal(t, s.expectedInBlockComment, inBlockComment)
+		})
+	}
+}
+
+func TestLanguageForPath(t *testing.T) {
+	assert.Equal(t, goLanguage, languageForPath("pkg/main.go"))
+	assert.Equal(t, javascriptLanguage, languageForPath("src/App.TSX"))
+	assert.Equal(t, shellLanguage, languageForPath("build/Makefile"))
+	assert.Nil(t, languageForPath("README"))
+}
+
+func TestPatchParserSyntaxTokens(t *testing.T) {
+	parser := NewPatchParser(nil, `diff --git a/file.go b/file.go
+index 1234567..89abcde 100644
+--- a/file.go
++++ b/file.go
+@@ -1,3 +1,3 @@
+ /*
+-old
++new */ var
+ x
+`)
+
+	tokens := parser.syntaxTokens()
+	assert.Equal(t, []syntaxToken{{span: span{start: 0, end: 2}, kind: SYNTAX_COMMENT}}, tokens[5])
+	// the removed line is still in the comment in the old version of the file
+	assert.Equal(t, []syntaxToken{{span: span{start: 0, end: 3}, kind: SYNTAX_COMMENT}}, tokens[6])
+	assert.Equal(t, []syntaxToken{
+		{span: span{start: 0, end: 6}, kind: SYNTAX_COMMENT},
+		{span: span{start: 7, end: 10}, kind: SYNTAX_KEYWORD},
+	}, tokens[7])
+	// and the context line that follows is a comment in the old version but not
+	// the new one, which we go by
+	assert.Equal(t, []syntaxToken{}, tokens[8])
+}
+
+func TestPatchParserSyntaxTokensUnknownLanguage(t *testing.T) {
+	parser := NewPatchParser(nil, `diff --git a/README b/README
+new file mode 100644
+--- /dev/null
++++ b/README
+@@ -0,0 +1 @@
++return
+`)
+
+	assert.Nil(t, parser.syntaxTokens()[5])
+}
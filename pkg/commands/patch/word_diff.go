@@ -0,0 +1,152 @@
+package patch
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+)
+
+// Here we emphasise the parts of a changed line that actually changed, like
+// git's --word-diff does. In each run of removed lines followed by added lines we
+// pair up the first removed line with the first added line and so on, and then
+// emphasise the words (or characters) of each pair that aren't common to both.
+
+// values of the git.wordDiff user config
+const (
+	WORD_DIFF_OFF  = "off"
+	WORD_DIFF_WORD = "word"
+	WORD_DIFF_CHAR = "char"
+)
+
+// past this many comparisons between the tokens of a pair of lines, we don't
+// bother emphasising anything, so that huge lines don't slow down rendering
+const maxWordDiffComparisons = 100000
+
+// if less than this share of a pair of lines is common to both, we take them to
+// be unrelated lines rather than the before and after of the same line
+const minWordDiffSimilarity = 0.4
+
+var (
+	wordDiffTokenRegex = regexp.MustCompile(`[\p{L}\p{N}_]+|\s+|.`)
+	ansiEscapeRegex    = regexp.MustCompile(`\x1B\[([0-9]{1,3}(;[0-9]{1,3})*)?[mGK]`)
+)
+
+// span is the range [start, end) of bytes in a line
+type span struct {
+	start int
+	end   int
+}
+
+func tokenize(line string, mode string) []string {
+	if mode == WORD_DIFF_CHAR {
+		return strings.Split(line, "")
+	}
+
+	return wordDiffTokenRegex.FindAllString(line, -1)
+}
+
+// changedSpans returns the parts of the before and after versions of a line
+// that aren't common to both, or nil if the lines are too different to compare
+func changedSpans(before string, after string, mode string) ([]span, []span) {
+	a := tokenize(before, mode)
+	b := tokenize(after, mode)
+	if len(a)*len(b) > maxWordDiffComparisons {
+		return nil, nil
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	commonInA := make([]bool, len(a))
+	commonInB := make([]bool, len(b))
+	commonBytes := 0
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			commonInA[i] = true
+			commonInB[j] = true
+			commonBytes += len(a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	if float64(2*commonBytes) < minWordDiffSimilarity*float64(len(before)+len(after)) {
+		return nil, nil
+	}
+
+	return uncommonSpans(a, commonInA), uncommonSpans(b, commonInB)
+}
+
+func uncommonSpans(tokens []string, common []bool) []span {
+	spans := []span{}
+	offset := 0
+	for i, token := range tokens {
+		if !common[i] {
+			if len(spans) > 0 && spans[len(spans)-1].end == offset {
+				spans[len(spans)-1].end += len(token)
+			} else {
+				spans = append(spans, span{start: offset, end: offset + len(token)})
+			}
+		}
+		offset += len(token)
+	}
+
+	return spans
+}
+
+// changedSpansForBlock pairs up a run of removed lines with the run of added
+// lines that follows it and returns the changed spans of each line. Lines are
+// given without their leading '-' or '+'.
+func changedSpansForBlock(deletions []string, additions []string, mode string) ([][]span, [][]span) {
+	deletionSpans := make([][]span, len(deletions))
+	additionSpans := make([][]span, len(additions))
+	if mode != WORD_DIFF_WORD && mode != WORD_DIFF_CHAR {
+		return deletionSpans, additionSpans
+	}
+
+	for i := 0; i < len(deletions) && i < len(additions); i++ {
+		deletionSpans[i], additionSpans[i] = changedSpans(deletions[i], additions[i], mode)
+	}
+
+	return deletionSpans, additionSpans
+}
+
+// emphasise renders str with the given spans of it in reverse video
+func emphasise(textStyle style.TextStyle, str string, spans []span) string {
+	emphasisStyle := textStyle.SetReverse()
+
+	result := ""
+	offset := 0
+	for _, s := range spans {
+		if s.start > offset {
+			result += textStyle.Sprint(str[offset:s.start])
+		}
+		result += emphasisStyle.Sprint(str[s.start:s.end])
+		offset = s.end
+	}
+	if offset < len(str) {
+		result += textStyle.Sprint(str[offset:])
+	}
+
+	return result
+}
@@ -0,0 +1,90 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangedSpans(t *testing.T) {
+	type scenario struct {
+		testName       string
+		before         string
+		after          string
+		mode           string
+		expectedBefore []span
+		expectedAfter  []span
+	}
+
+	scenarios := []scenario{
+		{
+			testName:       "changed word",
+			before:         "return foo(bar)",
+			after:          "return foo(baz)",
+			mode:           WORD_DIFF_WORD,
+			expectedBefore: []span{{start: 11, end: 14}},
+			expectedAfter:  []span{{start: 11, end: 14}},
+		},
+		{
+			testName:       "changed character",
+			before:         "return foo(bar)",
+			after:          "return foo(baz)",
+			mode:           WORD_DIFF_CHAR,
+			expectedBefore: []span{{start: 13, end: 14}},
+			expectedAfter:  []span{{start: 13, end: 14}},
+		},
+		{
+			testName:       "added words",
+			before:         "a := b",
+			after:          "a := b + c",
+			mode:           WORD_DIFF_WORD,
+			expectedBefore: []span{},
+			expectedAfter:  []span{{start: 6, end: 10}},
+		},
+		{
+			testName:       "unrelated lines",
+			before:         "func main() {",
+			after:          "\treturn nil",
+			mode:           WORD_DIFF_WORD,
+			expectedBefore: nil,
+			expectedAfter:  nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			before, after := changedSpans(s.before, s.after, s.mode)
+			assert.Equal(t, s.expectedBefore, before)
+			assert.Equal(t, s.expectedAfter, after)
+		})
+	}
+}
+
+const wordDiffPatch = `diff --git a/file.go b/file.go
+index 1234567..89abcde 100644
+--- a/file.go
++++ b/file.go
+@@ -1,5 +1,5 @@
+ package main
+-var a = 1
+-var b = 2
++var a = 10
++var c = 2
++var d = 3
+ func main() {}
+`
+
+func TestPatchParserChangedSpans(t *testing.T) {
+	parser := NewPatchParser(nil, wordDiffPatch)
+
+	assert.Equal(t, map[int][]span{
+		6:  {{start: 8, end: 9}},
+		7:  {{start: 4, end: 5}},
+		8:  {{start: 8, end: 10}},
+		9:  {{start: 4, end: 5}},
+		10: nil,
+	}, parser.changedSpans(WORD_DIFF_WORD))
+
+	assert.Equal(t, map[int][]span{}, parser.changedSpans(WORD_DIFF_OFF))
+}
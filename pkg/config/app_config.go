@@ -291,6 +291,30 @@ type AppState struct {
 	// these are for custom commands typed in directly, not for custom commands in the lazygit config
 	CustomCommandsHistory []string
 	HideCommandLog        bool
+
+	// the pull options last chosen from the pull menu, keyed by repo path and then
+	// branch name. These are used for subsequent pulls of that branch
+	PullPreferences map[string]map[string]PullPreference
+
+	// the path and change type filters of the file trees, keyed by repo path and
+	// then panel (one of files, commitFiles)
+	FileFilters map[string]map[string]FileFilter
+
+	// the tag sort order last chosen from the tags panel, keyed by repo path. This
+	// takes precedence over git.tagSortOrder
+	TagSortOrders map[string]string
+}
+
+type PullPreference struct {
+	// one of rebase, merge, ff-only
+	Strategy  string
+	AutoStash bool
+}
+
+type FileFilter struct {
+	Text string
+	// any of added, modified, deleted, renamed, untracked, conflicted
+	ChangeTypes []string
 }
 
 func getDefaultAppState() *AppState {
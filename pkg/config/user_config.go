@@ -26,6 +26,9 @@ type UserConfig struct {
 type RefresherConfig struct {
 	RefreshInterval int `yaml:"refreshInterval"`
 	FetchInterval   int `yaml:"fetchInterval"`
+	// fetch intervals in seconds for specific remotes, overriding FetchInterval.
+	// A remote with an interval of 0 is not auto-fetched
+	RemoteFetchIntervals map[string]int `yaml:"remoteFetchIntervals"`
 }
 
 type GuiConfig struct {
@@ -52,6 +55,11 @@ type GuiConfig struct {
 	ShowIcons                bool               `yaml:"showIcons"`
 	CommandLogSize           int                `yaml:"commandLogSize"`
 	SplitDiff                string             `yaml:"splitDiff"`
+	// show how far each branch is ahead of and behind the base branch
+	ShowDivergenceFromBaseBranch bool `yaml:"showDivergenceFromBaseBranch"`
+	// show how many lines were added and deleted in each file of the files and
+	// commit files panels
+	ShowFileDiffStats bool `yaml:"showFileDiffStats"`
 }
 
 type ThemeConfig struct {
@@ -74,6 +82,7 @@ type GitConfig struct {
 	Paging              PagingConfig                  `yaml:"paging"`
 	Commit              CommitConfig                  `yaml:"commit"`
 	Merging             MergingConfig                 `yaml:"merging"`
+	Rebasing            RebasingConfig                `yaml:"rebasing"`
 	SkipHookPrefix      string                        `yaml:"skipHookPrefix"`
 	AutoFetch           bool                          `yaml:"autoFetch"`
 	AutoRefresh         bool                          `yaml:"autoRefresh"`
@@ -86,6 +95,54 @@ type GitConfig struct {
 	ParseEmoji      bool      `yaml:"parseEmoji"`
 	Log             LogConfig `yaml:"log"`
 	DiffContextSize int       `yaml:"diffContextSize"`
+	// one of off, word, char. Emphasises the changed words (or characters) of
+	// changed lines. Doesn't apply when using a pager
+	WordDiff string `yaml:"wordDiff"`
+	// shows the old and new versions of files next to each other in diffs. Doesn't
+	// apply when using a pager
+	SideBySideDiff bool `yaml:"sideBySideDiff"`
+	// colours the content of diffs by the syntax of the file's language, going by
+	// its extension. Doesn't apply when using a pager
+	SyntaxHighlighting bool `yaml:"syntaxHighlighting"`
+	// one of date, semver, alphabetical
+	TagSortOrder string `yaml:"tagSortOrder"`
+	// one of recency, alphabetical, date
+	BranchSortOrder string `yaml:"branchSortOrder"`
+	// keeps branches with the same prefix (e.g. feature/) together in the branches panel
+	GroupBranchesByPrefix bool `yaml:"groupBranchesByPrefix"`
+	// branches which other branches are eventually merged into. Used to find
+	// branches which can be cleaned up
+	MainBranches []string `yaml:"mainBranches"`
+	// the ref that branches are compared against to show how far they've diverged.
+	// Can be overridden per repo from the branches panel. If empty, the remote's
+	// default branch (origin/HEAD) is used
+	BaseBranch     string               `yaml:"baseBranch"`
+	BranchingModel BranchingModelConfig `yaml:"branchingModel"`
+}
+
+// BranchingModelConfig describes the kinds of short-lived branches used in a repo
+// and how they're started and finished, like git-flow does
+type BranchingModelConfig struct {
+	BranchTypes []BranchTypeConfig `yaml:"branchTypes"`
+}
+
+type BranchTypeConfig struct {
+	// e.g. 'feature'
+	Name string `yaml:"name"`
+	// e.g. 'feature/'. Branches starting with this prefix are of this type
+	Prefix string `yaml:"prefix"`
+	// the branch that new branches of this type are started from
+	Base string `yaml:"base"`
+	// the branches that a finished branch is merged into, in order
+	MergeInto []string `yaml:"mergeInto"`
+	// one of 'merge' | 'no-ff' | 'ff-only'
+	MergeStrategy string `yaml:"mergeStrategy"`
+	// whether to tag the first branch in MergeInto after merging into it. The tag is
+	// named TagPrefix followed by the name of the branch without its prefix
+	Tag       bool   `yaml:"tag"`
+	TagPrefix string `yaml:"tagPrefix"`
+	// whether to delete the branch once it's been merged
+	DeleteAfterFinish bool `yaml:"deleteAfterFinish"`
 }
 
 type PagingConfig struct {
@@ -103,10 +160,20 @@ type MergingConfig struct {
 	Args         string `yaml:"args"`
 }
 
+type RebasingConfig struct {
+	// recreate merge commits when rebasing a branch onto another, rather than flattening them
+	RebaseMerges bool `yaml:"rebaseMerges"`
+}
+
 type LogConfig struct {
 	Order          string `yaml:"order"`     // one of date-order, author-date-order, topo-order
 	ShowGraph      string `yaml:"showGraph"` // one of always, never, when-maximised
 	ShowWholeGraph bool   `yaml:"showWholeGraph"`
+	// loads the signature status of each commit (via %G?). This requires
+	// verifying every signed commit so it is off by default
+	ShowSignatures bool `yaml:"showSignatures"`
+	// shows how many lines each commit inserted and deleted (via --shortstat)
+	ShowDiffStats bool `yaml:"showDiffStats"`
 }
 
 type CommitPrefixConfig struct {
@@ -178,6 +245,8 @@ type KeybindingUniversalConfig struct {
 	CreateRebaseOptionsMenu      string   `yaml:"createRebaseOptionsMenu"`
 	PushFiles                    string   `yaml:"pushFiles"`
 	PullFiles                    string   `yaml:"pullFiles"`
+	ViewPushOptions              string   `yaml:"viewPushOptions"`
+	ViewPullOptions              string   `yaml:"viewPullOptions"`
 	Refresh                      string   `yaml:"refresh"`
 	CreatePatchOptionsMenu       string   `yaml:"createPatchOptionsMenu"`
 	NextTab                      string   `yaml:"nextTab"`
@@ -195,6 +264,9 @@ type KeybindingUniversalConfig struct {
 	AppendNewline                string   `yaml:"appendNewline"`
 	ExtrasMenu                   string   `yaml:"extrasMenu"`
 	ToggleWhitespaceInDiffView   string   `yaml:"toggleWhitespaceInDiffView"`
+	ToggleWordDiff               string   `yaml:"toggleWordDiff"`
+	ToggleSideBySideDiff         string   `yaml:"toggleSideBySideDiff"`
+	ToggleSyntaxHighlighting     string   `yaml:"toggleSyntaxHighlighting"`
 	IncreaseContextInDiffView    string   `yaml:"increaseContextInDiffView"`
 	DecreaseContextInDiffView    string   `yaml:"decreaseContextInDiffView"`
 }
@@ -219,6 +291,7 @@ type KeybindingFilesConfig struct {
 	Fetch                    string `yaml:"fetch"`
 	ToggleTreeView           string `yaml:"toggleTreeView"`
 	OpenMergeTool            string `yaml:"openMergeTool"`
+	ResolveConflict          string `yaml:"resolveConflict"`
 	OpenStatusFilter         string `yaml:"openStatusFilter"`
 }
 
@@ -236,6 +309,14 @@ type KeybindingBranchesConfig struct {
 	PushTag                string `yaml:"pushTag"`
 	SetUpstream            string `yaml:"setUpstream"`
 	FetchRemote            string `yaml:"fetchRemote"`
+	SortOrder              string `yaml:"sortOrder"`
+	TagSortOrder           string `yaml:"tagSortOrder"`
+	DeleteRemoteTag        string `yaml:"deleteRemoteTag"`
+	CleanUpBranches        string `yaml:"cleanUpBranches"`
+	FetchAllRemotes        string `yaml:"fetchAllRemotes"`
+	EditRemotePushUrl      string `yaml:"editRemotePushUrl"`
+	SetRemoteHead          string `yaml:"setRemoteHead"`
+	SetBaseBranch          string `yaml:"setBaseBranch"`
 }
 
 type KeybindingCommitsConfig struct {
@@ -262,6 +343,7 @@ type KeybindingCommitsConfig struct {
 	OpenLogMenu                    string `yaml:"openLogMenu"`
 	OpenInBrowser                  string `yaml:"openInBrowser"`
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
+	SplitCommit                    string `yaml:"splitCommit"`
 }
 
 type KeybindingStashConfig struct {
@@ -278,6 +360,11 @@ type KeybindingMainConfig struct {
 	ToggleSelectHunk    string `yaml:"toggleSelectHunk"`
 	PickBothHunks       string `yaml:"pickBothHunks"`
 	EditSelectHunk      string `yaml:"editSelectHunk"`
+	ToggleThreeWayMerge string `yaml:"toggleThreeWayMerge"`
+	PickOurs            string `yaml:"pickOurs"`
+	PickBase            string `yaml:"pickBase"`
+	PickTheirs          string `yaml:"pickTheirs"`
+	EditMergeResultLine string `yaml:"editMergeResultLine"`
 }
 
 type KeybindingSubmodulesConfig struct {
@@ -365,16 +452,18 @@ func GetDefaultConfig() *UserConfig {
 				CherryPickedCommitFgColor: []string{"blue"},
 				UnstagedChangesColor:      []string{"red"},
 			},
-			CommitLength:             CommitLengthConfig{Show: true},
-			SkipNoStagedFilesWarning: false,
-			ShowListFooter:           true,
-			ShowCommandLog:           true,
-			ShowBottomLine:           true,
-			ShowFileTree:             true,
-			ShowRandomTip:            true,
-			ShowIcons:                false,
-			CommandLogSize:           8,
-			SplitDiff:                "auto",
+			CommitLength:                 CommitLengthConfig{Show: true},
+			SkipNoStagedFilesWarning:     false,
+			ShowListFooter:               true,
+			ShowCommandLog:               true,
+			ShowBottomLine:               true,
+			ShowFileTree:                 true,
+			ShowRandomTip:                true,
+			ShowIcons:                    false,
+			CommandLogSize:               8,
+			SplitDiff:                    "auto",
+			ShowDivergenceFromBaseBranch: false,
+			ShowFileDiffStats:            false,
 		},
 		Git: GitConfig{
 			Paging: PagingConfig{
@@ -389,10 +478,15 @@ func GetDefaultConfig() *UserConfig {
 				ManualCommit: false,
 				Args:         "",
 			},
+			Rebasing: RebasingConfig{
+				RebaseMerges: false,
+			},
 			Log: LogConfig{
 				Order:          "topo-order",
 				ShowGraph:      "when-maximised",
 				ShowWholeGraph: false,
+				ShowSignatures: false,
+				ShowDiffStats:  false,
 			},
 			SkipHookPrefix:      "WIP",
 			AutoFetch:           true,
@@ -403,10 +497,56 @@ func GetDefaultConfig() *UserConfig {
 			CommitPrefixes:      map[string]CommitPrefixConfig(nil),
 			ParseEmoji:          false,
 			DiffContextSize:     3,
+			WordDiff:            "off",
+			SideBySideDiff:      false,
+			SyntaxHighlighting:  false,
+			TagSortOrder:        "date",
+			BranchSortOrder:     "recency",
+			MainBranches:        []string{"master", "main"},
+			BaseBranch:          "",
+			BranchingModel: BranchingModelConfig{
+				BranchTypes: []BranchTypeConfig{
+					{
+						Name:              "feature",
+						Prefix:            "feature/",
+						Base:              "develop",
+						MergeInto:         []string{"develop"},
+						MergeStrategy:     "no-ff",
+						DeleteAfterFinish: true,
+					},
+					{
+						Name:              "bugfix",
+						Prefix:            "bugfix/",
+						Base:              "develop",
+						MergeInto:         []string{"develop"},
+						MergeStrategy:     "no-ff",
+						DeleteAfterFinish: true,
+					},
+					{
+						Name:              "release",
+						Prefix:            "release/",
+						Base:              "develop",
+						MergeInto:         []string{"master", "develop"},
+						MergeStrategy:     "no-ff",
+						Tag:               true,
+						DeleteAfterFinish: true,
+					},
+					{
+						Name:              "hotfix",
+						Prefix:            "hotfix/",
+						Base:              "master",
+						MergeInto:         []string{"master", "develop"},
+						MergeStrategy:     "no-ff",
+						Tag:               true,
+						DeleteAfterFinish: true,
+					},
+				},
+			},
 		},
 		Refresher: RefresherConfig{
-			RefreshInterval: 10,
-			FetchInterval:   60,
+			RefreshInterval:      10,
+			FetchInterval:        60,
+			RemoteFetchIntervals: map[string]int{},
 		},
 		Update: UpdateConfig{
 			Method: "prompt",
@@ -464,6 +604,8 @@ func GetDefaultConfig() *UserConfig {
 				CreateRebaseOptionsMenu:      "m",
 				PushFiles:                    "P",
 				PullFiles:                    "p",
+				ViewPushOptions:              "U",
+				ViewPullOptions:              "Y",
 				Refresh:                      "R",
 				CreatePatchOptionsMenu:       "<c-p>",
 				NextTab:                      "]",
@@ -480,6 +622,9 @@ func GetDefaultConfig() *UserConfig {
 				AppendNewline:                "<a-enter>",
 				ExtrasMenu:                   "@",
 				ToggleWhitespaceInDiffView:   "<c-w>",
+				ToggleWordDiff:               "<c-t>",
+				ToggleSideBySideDiff:         "|",
+				ToggleSyntaxHighlighting:     "<c-x>",
 				IncreaseContextInDiffView:    "}",
 				DecreaseContextInDiffView:    "{",
 			},
@@ -502,6 +647,7 @@ func GetDefaultConfig() *UserConfig {
 				Fetch:                    "f",
 				ToggleTreeView:           "`",
 				OpenMergeTool:            "M",
+				ResolveConflict:          "X",
 				OpenStatusFilter:         "<c-b>",
 			},
 			Branches: KeybindingBranchesConfig{
@@ -518,6 +664,14 @@ func GetDefaultConfig() *UserConfig {
 				PushTag:                "P",
 				SetUpstream:            "u",
 				FetchRemote:            "f",
+				SortOrder:              "s",
+				TagSortOrder:           "s",
+				DeleteRemoteTag:        "D",
+				CleanUpBranches:        "C",
+				FetchAllRemotes:        "F",
+				EditRemotePushUrl:      "E",
+				SetRemoteHead:          "S",
+				SetBaseBranch:          "B",
 			},
 			Commits: KeybindingCommitsConfig{
 				SquashDown:                     "s",
@@ -543,6 +697,7 @@ func GetDefaultConfig() *UserConfig {
 				OpenLogMenu:                    "<c-l>",
 				OpenInBrowser:                  "o",
 				ViewBisectOptions:              "b",
+				SplitCommit:                    "X",
 			},
 			Stash: KeybindingStashConfig{
 				PopStash: "g",
@@ -556,6 +711,11 @@ func GetDefaultConfig() *UserConfig {
 				ToggleSelectHunk:    "a",
 				PickBothHunks:       "b",
 				EditSelectHunk:      "E",
+				ToggleThreeWayMerge: "t",
+				PickOurs:            "O",
+				PickBase:            "B",
+				PickTheirs:          "T",
+				EditMergeResultLine: "c",
 			},
 			Submodules: KeybindingSubmodulesConfig{
 				Init:     "i",
@@ -0,0 +1,174 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// backgroundFetcher keeps track of when each remote is next due to be fetched.
+// Each remote has its own interval (see refresher.remoteFetchIntervals) so we check
+// every second which remotes are due rather than fetching everything on one timer.
+// Remote names only mean something within a repo, so each repo has its own
+// fetcher (see GuiRepoState).
+type backgroundFetcher struct {
+	nextFetchTimes map[string]time.Time
+	// remotes which wanted credentials. We can't ask for those in the background,
+	// so rather than failing on every attempt we stop fetching these remotes
+	skippedRemotes *set.Set[string]
+	alerted        bool
+}
+
+func newBackgroundFetcher() *backgroundFetcher {
+	return &backgroundFetcher{
+		nextFetchTimes: map[string]time.Time{},
+		skippedRemotes: set.New[string](),
+	}
+}
+
+// autoFetchConfigured tells us whether any remote has a positive fetch interval,
+// either through refresher.fetchInterval or an override for that remote
+func autoFetchConfigured(refresherConfig config.RefresherConfig) bool {
+	if refresherConfig.FetchInterval > 0 {
+		return true
+	}
+
+	for _, seconds := range refresherConfig.RemoteFetchIntervals {
+		if seconds > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (gui *Gui) startBackgroundFetch() {
+	gui.waitForIntro.Wait()
+
+	gui.goEvery(time.Second, gui.stopChan, func() error {
+		gui.fetchDueRemotes()
+		return nil
+	})
+}
+
+func (gui *Gui) fetchDueRemotes() {
+	fetcher := gui.State.BackgroundFetcher
+	remotes := gui.State.Model.Remotes
+
+	for _, remote := range remotes {
+		if fetcher.skippedRemotes.Includes(remote.Name) {
+			continue
+		}
+
+		interval := gui.remoteFetchInterval(remote.Name)
+		if interval <= 0 {
+			continue
+		}
+
+		now := time.Now()
+		if nextFetchTime, ok := fetcher.nextFetchTimes[remote.Name]; ok && now.Before(nextFetchTime) {
+			continue
+		}
+		fetcher.nextFetchTimes[remote.Name] = now.Add(interval)
+
+		err := gui.backgroundFetchRemote(remote.Name)
+		if err == nil {
+			continue
+		}
+
+		if !git_commands.IsAuthError(err) {
+			// most likely a network problem, so we'll try again next time
+			gui.c.Log.Warnf("background fetch of remote '%s' failed: %s", remote.Name, err.Error())
+			continue
+		}
+
+		gui.c.Log.Warnf("remote '%s' requires credentials, disabling auto-fetch for it", remote.Name)
+		fetcher.skippedRemotes.Add(remote.Name)
+		if gui.IsNewRepo && !fetcher.alerted {
+			fetcher.alerted = true
+			_ = gui.c.Alert(gui.c.Tr.NoAutomaticGitFetchTitle, gui.c.Tr.NoAutomaticGitFetchBody)
+		}
+	}
+}
+
+func (gui *Gui) remoteFetchInterval(remoteName string) time.Duration {
+	seconds, ok := gui.UserConfig.Refresher.RemoteFetchIntervals[remoteName]
+	if !ok {
+		seconds = gui.UserConfig.Refresher.FetchInterval
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// backgroundFetchRemote fetches the remote and lets the user know about any new
+// commits on the upstreams of their local branches
+func (gui *Gui) backgroundFetchRemote(remoteName string) error {
+	shasBefore, err := gui.git.Sync.RemoteBranchShas(remoteName)
+	if err != nil {
+		return err
+	}
+
+	if err := gui.git.Sync.Fetch(git_commands.FetchOptions{Background: true, RemoteName: remoteName}); err != nil {
+		return err
+	}
+
+	shasAfter, err := gui.git.Sync.RemoteBranchShas(remoteName)
+	if err != nil {
+		return err
+	}
+
+	branchNames, summaries := gui.branchesWithNewUpstreamCommits(remoteName, shasBefore, shasAfter)
+	if len(branchNames) > 0 {
+		gui.onUIThread(func() error {
+			gui.State.Model.BranchesWithNewCommits.Add(branchNames...)
+			gui.toast(utils.ResolvePlaceholderString(
+				gui.c.Tr.NewCommitsFetched,
+				map[string]string{
+					"remoteName": remoteName,
+					"branches":   strings.Join(summaries, ", "),
+				},
+			))
+			return nil
+		})
+	}
+
+	_ = gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.ASYNC})
+	gui.render()
+
+	return nil
+}
+
+// branchesWithNewUpstreamCommits returns the local branches tracking the remote
+// whose upstreams moved in the fetch, along with a summary like 'main (+3)' for each
+func (gui *Gui) branchesWithNewUpstreamCommits(remoteName string, shasBefore map[string]string, shasAfter map[string]string) ([]string, []string) {
+	branchNames := []string{}
+	summaries := []string{}
+
+	for _, branch := range gui.State.Model.Branches {
+		if branch.UpstreamRemote != remoteName {
+			continue
+		}
+
+		before := shasBefore[branch.UpstreamBranch]
+		after := shasAfter[branch.UpstreamBranch]
+		if before == "" || after == "" || before == after {
+			continue
+		}
+
+		_, newCommitCount := gui.git.Branch.GetCommitDifferences(before, after)
+		if newCommitCount == "0" || newCommitCount == "?" {
+			continue
+		}
+
+		branchNames = append(branchNames, branch.Name)
+		summaries = append(summaries, fmt.Sprintf("%s (+%s)", branch.Name, newCommitCount))
+	}
+
+	return branchNames, summaries
+}
@@ -1,7 +1,10 @@
 package gui
 
 import (
+	"fmt"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -39,16 +42,30 @@ func (gui *Gui) branchCommitsRenderToMain() error {
 		task = types.NewRunPtyTask(cmdObj.GetCmd())
 	}
 
+	secondary := gui.secondaryPatchPanelUpdateOpts()
+	if secondary == nil && commit != nil && commit.IsSigned() {
+		secondary = gui.signaturePanelUpdateOpts(gui.git.Commit.VerifyCmdObj(commit.Sha))
+	}
+
 	return gui.c.RenderToMainViews(types.RefreshMainOpts{
 		Pair: gui.c.MainViewPairs().Normal,
 		Main: &types.ViewUpdateOpts{
 			Title: "Patch",
 			Task:  task,
 		},
-		Secondary: gui.secondaryPatchPanelUpdateOpts(),
+		Secondary: secondary,
 	})
 }
 
+// signaturePanelUpdateOpts shows the output of verifying a commit or tag's
+// signature, which is useful for checking who signed it and whether we trust them
+func (gui *Gui) signaturePanelUpdateOpts(cmdObj oscommands.ICmdObj) *types.ViewUpdateOpts {
+	return &types.ViewUpdateOpts{
+		Task:  types.NewRunCommandTask(cmdObj.GetCmd()),
+		Title: fmt.Sprintf("%s (%s)", gui.c.Tr.Signature, gui.git.Config.GetSigningFormat()),
+	}
+}
+
 func (gui *Gui) secondaryPatchPanelUpdateOpts() *types.ViewUpdateOpts {
 	if gui.git.Patch.PatchManager.Active() {
 		patch := gui.git.Patch.PatchManager.RenderAggregatedPatchColored(false)
@@ -29,6 +29,10 @@ func (self *BasicViewModel[T]) GetSelected() T {
 	return self.getModel()[self.GetSelectedLineIdx()]
 }
 
+func (self *BasicViewModel[T]) GetItems() []T {
+	return self.getModel()
+}
+
 func Zero[T any]() T {
 	return *new(T)
 }
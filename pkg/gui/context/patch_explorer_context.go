@@ -105,7 +105,7 @@ func (self *PatchExplorerContext) focusSelection() {
 	newOrigin := state.CalculateOrigin(origin, bufferHeight)
 
 	_ = view.SetOriginY(newOrigin)
-	_ = view.SetCursor(0, selectedLineIdx-newOrigin)
+	_ = view.SetCursor(0, state.ViewLineIdx(selectedLineIdx)-newOrigin)
 }
 
 func (self *PatchExplorerContext) GetContentToRender(isFocused bool) string {
@@ -113,12 +113,28 @@ func (self *PatchExplorerContext) GetContentToRender(isFocused bool) string {
 		return ""
 	}
 
-	return self.GetState().RenderForLineIndices(isFocused, self.GetIncludedLineIndices())
+	return self.GetState().RenderForLineIndices(
+		isFocused,
+		self.GetIncludedLineIndices(),
+		self.c.UserConfig.Git.WordDiff,
+		self.sideBySideWidth(),
+		self.c.UserConfig.Git.SyntaxHighlighting,
+	)
 }
 
+func (self *PatchExplorerContext) sideBySideWidth() int {
+	if !self.c.UserConfig.Git.SideBySideDiff {
+		return 0
+	}
+
+	width, _ := self.GetView().Size()
+	return width
+}
+
+// NavigateTo selects the patch line at the given line of the view
 func (self *PatchExplorerContext) NavigateTo(isFocused bool, selectedLineIdx int) error {
 	self.GetState().SetLineSelectMode()
-	self.GetState().SelectLine(selectedLineIdx)
+	self.GetState().SelectLine(self.GetState().PatchLineIdx(selectedLineIdx))
 
 	return self.RenderAndFocus(isFocused)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/splitting"
 	"github.com/jesseduffield/lazygit/pkg/gui/services/custom_commands"
 )
 
@@ -22,7 +23,9 @@ func (gui *Gui) resetControllers() {
 		model,
 	)
 
-	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, gui.State.Contexts, gui.git, refsHelper)
+	setCommitMessage := gui.getSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitMessage })
+
+	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, gui.State.Contexts, gui.git, refsHelper, setCommitMessage)
 	suggestionsHelper := helpers.NewSuggestionsHelper(helperCommon, model, gui.refreshSuggestions)
 	gui.helpers = &helpers.Helpers{
 		Refs:           refsHelper,
@@ -44,6 +47,16 @@ func (gui *Gui) resetControllers() {
 			rebaseHelper,
 		),
 		Upstream: helpers.NewUpstreamHelper(helperCommon, model, suggestionsHelper.GetRemoteBranchesSuggestionsFunc),
+		SplitCommit: helpers.NewSplitCommitHelper(
+			helperCommon,
+			gui.git,
+			gui.State.Contexts,
+			model,
+			func() *splitting.Splitting { return gui.State.Modes.Splitting },
+			rebaseHelper,
+		),
+		EditHunk:   helpers.NewEditHunkHelper(helperCommon, gui.git, osCommand),
+		FileFilter: helpers.NewFileFilterHelper(helperCommon, gui.State.Contexts),
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -84,8 +97,6 @@ func (gui *Gui) resetControllers() {
 		return strings.TrimSpace(gui.Views.CommitMessage.TextArea.GetContent())
 	}
 
-	setCommitMessage := gui.getSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitMessage })
-
 	onCommitAttempt := func(message string) {
 		gui.State.savedCommitMessage = message
 		gui.Views.CommitMessage.ClearTextArea()
@@ -121,6 +132,7 @@ func (gui *Gui) resetControllers() {
 	undoController := controllers.NewUndoController(common)
 	globalController := controllers.NewGlobalController(common)
 	contextLinesController := controllers.NewContextLinesController(common)
+	diffDisplayController := controllers.NewDiffDisplayController(common)
 	verticalScrollControllerFactory := controllers.NewVerticalScrollControllerFactory(common)
 
 	branchesController := controllers.NewBranchesController(common)
@@ -248,6 +260,7 @@ func (gui *Gui) resetControllers() {
 		undoController,
 		globalController,
 		contextLinesController,
+		diffDisplayController,
 	)
 
 	// this must come last so that we've got our click handlers defined against the context
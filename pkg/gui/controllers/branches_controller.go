@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -103,6 +105,24 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Description: self.c.Tr.LcSetUnsetUpstream,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.SortOrder),
+			Handler:     self.createSortMenu,
+			Description: self.c.Tr.LcSortOrder,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CleanUpBranches),
+			Handler:     self.cleanUpBranches,
+			Description: self.c.Tr.LcCleanUpBranches,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.SetBaseBranch),
+			Handler:     self.checkSelected(self.createBaseBranchMenu),
+			Description: self.c.Tr.LcBaseBranchOptions,
+			OpensMenu:   true,
+		},
 	}
 }
 
@@ -476,6 +496,293 @@ func (self *BranchesController) createPullRequest(from string, to string) error
 	return nil
 }
 
+func (self *BranchesController) createSortMenu() error {
+	onPress := func(value string) func() error {
+		return func() error {
+			self.c.UserConfig.Git.BranchSortOrder = value
+			return self.refreshSorted()
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.BranchSortOrderTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   "recency",
+				OnPress: onPress("recency"),
+			},
+			{
+				Label:   "alphabetical",
+				OnPress: onPress("alphabetical"),
+			},
+			{
+				Label:   "date",
+				OnPress: onPress("date"),
+			},
+			{
+				Label: self.c.Tr.LcToggleGroupBranchesByPrefix,
+				Key:   'g',
+				OnPress: func() error {
+					self.c.UserConfig.Git.GroupBranchesByPrefix = !self.c.UserConfig.Git.GroupBranchesByPrefix
+					return self.refreshSorted()
+				},
+			},
+		},
+	})
+}
+
+func (self *BranchesController) createBaseBranchMenu(selectedBranch *models.Branch) error {
+	currentBaseBranch := self.git.Branch.GetBaseBranch()
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.BaseBranchMenuTitle,
+			map[string]string{"baseBranch": currentBaseBranch},
+		),
+		Items: []*types.MenuItem{
+			{
+				Label: utils.ResolvePlaceholderString(
+					self.c.Tr.LcSetAsBaseBranch,
+					map[string]string{"branchName": selectedBranch.Name},
+				),
+				Key: 's',
+				OnPress: func() error {
+					return self.setBaseBranch(selectedBranch.Name)
+				},
+			},
+			{
+				Label: self.c.Tr.LcEnterBaseBranch,
+				Key:   'e',
+				OnPress: func() error {
+					return self.c.Prompt(types.PromptOpts{
+						Title:               self.c.Tr.BaseBranchPromptTitle,
+						InitialContent:      currentBaseBranch,
+						FindSuggestionsFunc: self.helpers.Suggestions.GetRefsSuggestionsFunc(),
+						HandleConfirm:       self.setBaseBranch,
+					})
+				},
+			},
+			{
+				Label: self.c.Tr.LcResetBaseBranch,
+				Key:   'r',
+				OnPress: func() error {
+					return self.setBaseBranch("")
+				},
+			},
+			{
+				Label: self.c.Tr.LcToggleShowBaseBranchDivergence,
+				Key:   'd',
+				OnPress: func() error {
+					self.c.UserConfig.Gui.ShowDivergenceFromBaseBranch = !self.c.UserConfig.Gui.ShowDivergenceFromBaseBranch
+					return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+				},
+			},
+		},
+	})
+}
+
+func (self *BranchesController) setBaseBranch(refName string) error {
+	self.c.LogAction(self.c.Tr.Actions.SetBaseBranch)
+	if err := self.git.Branch.SetBaseBranch(refName); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS}})
+}
+
+func (self *BranchesController) refreshSorted() error {
+	self.context().SetSelectedLineIdx(0)
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+}
+
+type branchCleanupCandidate struct {
+	branch   *models.Branch
+	reason   string
+	selected bool
+}
+
+// cleanUpBranches lets the user pick from the branches which have either been merged
+// into one of the main branches or whose upstream has been deleted, and deletes them
+func (self *BranchesController) cleanUpBranches() error {
+	candidates, err := self.branchCleanupCandidates()
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if len(candidates) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoBranchesToCleanUp)
+	}
+
+	return self.cleanUpBranchesMenu(candidates, 0)
+}
+
+func (self *BranchesController) branchCleanupCandidates() ([]*branchCleanupCandidate, error) {
+	branches := self.model.Branches
+	isMainBranch := func(name string) bool {
+		return slices.Contains(self.c.UserConfig.Git.MainBranches, name)
+	}
+
+	mergedInto := map[string]string{}
+	for _, branch := range branches {
+		if !isMainBranch(branch.Name) {
+			continue
+		}
+
+		merged, err := self.git.Branch.MergedBranches(branch.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range merged {
+			if _, ok := mergedInto[name]; !ok {
+				mergedInto[name] = branch.Name
+			}
+		}
+	}
+
+	candidates := []*branchCleanupCandidate{}
+	for _, branch := range branches {
+		if branch.Head || isMainBranch(branch.Name) {
+			continue
+		}
+
+		// Only branches that have been merged are selected to begin with: a branch
+		// whose upstream is gone may still have commits that exist nowhere else
+		reason := ""
+		selected := false
+		if mainBranch, ok := mergedInto[branch.Name]; ok {
+			reason = utils.ResolvePlaceholderString(self.c.Tr.MergedIntoBranch, map[string]string{"branchName": mainBranch})
+			selected = true
+		} else if branch.UpstreamGone {
+			reason = self.c.Tr.UpstreamGone
+		} else {
+			continue
+		}
+
+		candidates = append(candidates, &branchCleanupCandidate{branch: branch, reason: reason, selected: selected})
+	}
+
+	return candidates, nil
+}
+
+// pressing a branch in the menu toggles whether it's selected, after which we
+// show the menu again with the same item selected
+func (self *BranchesController) cleanUpBranchesMenu(candidates []*branchCleanupCandidate, selectedIdx int) error {
+	selected := slices.Filter(candidates, func(candidate *branchCleanupCandidate) bool {
+		return candidate.selected
+	})
+
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{
+				"",
+				utils.ResolvePlaceholderString(self.c.Tr.LcDeleteSelectedBranches, map[string]string{"count": fmt.Sprintf("%d", len(selected))}),
+			},
+			Key: 'd',
+			OnPress: func() error {
+				return self.deleteBranches(slices.Map(selected, func(candidate *branchCleanupCandidate) *models.Branch {
+					return candidate.branch
+				}))
+			},
+		},
+	}
+
+	for i, candidate := range candidates {
+		i, candidate := i, candidate
+		checkbox := "[ ]"
+		if candidate.selected {
+			checkbox = "[x]"
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{checkbox, candidate.branch.Name, style.FgYellow.Sprint(candidate.reason)},
+			OnPress: func() error {
+				candidate.selected = !candidate.selected
+				return self.cleanUpBranchesMenu(candidates, i+1)
+			},
+		})
+	}
+
+	if err := self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CleanUpBranchesTitle,
+		Items: menuItems,
+	}); err != nil {
+		return err
+	}
+
+	self.contexts.Menu.SetSelectedLineIdx(selectedIdx)
+	return self.c.PostRefreshUpdate(self.contexts.Menu)
+}
+
+func (self *BranchesController) deleteBranches(branches []*models.Branch) error {
+	if len(branches) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoBranchesSelected)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.CleanUpBranchesTitle,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.CleanUpBranchesPrompt,
+			map[string]string{"count": fmt.Sprintf("%d", len(branches))},
+		),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func() error {
+				self.c.LogAction(self.c.Tr.Actions.CleanUpBranches)
+				// a branch merged into a main branch isn't necessarily merged into HEAD,
+				// in which case git refuses to delete it. We collect those and ask
+				// separately whether to force delete them
+				unmerged := []*models.Branch{}
+				for _, branch := range branches {
+					if err := self.git.Branch.Delete(branch.Name, false); err != nil {
+						if strings.Contains(err.Error(), "git branch -D ") {
+							unmerged = append(unmerged, branch)
+							continue
+						}
+						_ = self.c.Error(err)
+						break
+					}
+				}
+
+				self.context().SetSelectedLineIdx(0)
+				if err := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}}); err != nil {
+					return err
+				}
+
+				if len(unmerged) > 0 {
+					return self.forceDeleteBranches(unmerged)
+				}
+				return nil
+			})
+		},
+	})
+}
+
+func (self *BranchesController) forceDeleteBranches(branches []*models.Branch) error {
+	branchNames := slices.Map(branches, func(branch *models.Branch) string {
+		return branch.Name
+	})
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.CleanUpBranchesTitle,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.ForceCleanUpBranchesPrompt,
+			map[string]string{"branchNames": strings.Join(branchNames, ", ")},
+		),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func() error {
+				self.c.LogAction(self.c.Tr.Actions.CleanUpBranches)
+				for _, branchName := range branchNames {
+					if err := self.git.Branch.Delete(branchName, true); err != nil {
+						_ = self.c.Error(err)
+						break
+					}
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+			})
+		},
+	})
+}
+
 func (self *BranchesController) checkSelected(callback func(*models.Branch) error) func() error {
 	return func() error {
 		selectedItem := self.context().GetSelected()
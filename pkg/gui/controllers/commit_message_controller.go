@@ -70,7 +70,7 @@ func (self *CommitMessageController) confirm() error {
 	_ = self.c.PopContext()
 	return self.helpers.GPG.WithGpgHandling(cmdObj, self.c.Tr.CommittingStatus, func() error {
 		self.onCommitSuccess()
-		return nil
+		return self.helpers.SplitCommit.AfterCommit()
 	})
 }
 
@@ -5,6 +5,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -67,11 +68,20 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			Handler:     self.toggleTreeView,
 			Description: self.c.Tr.LcToggleTreeView,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.OpenStatusFilter),
+			Handler:     self.openFilterMenu,
+			Description: self.c.Tr.LcFileFilter,
+		},
 	}
 
 	return bindings
 }
 
+func (self *CommitFilesController) openFilterMenu() error {
+	return self.helpers.FileFilter.OpenMenu(helpers.FILE_FILTER_PANEL_COMMIT_FILES)
+}
+
 func (self *CommitFilesController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
 	return []*gocui.ViewMouseBinding{
 		{
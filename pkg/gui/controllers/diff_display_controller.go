@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// This controller lets you change how diffs are displayed: whether the changed
+// words of changed lines are highlighted (see patch/word_diff.go), whether the
+// old and new versions are shown side by side (see patch/side_by_side.go) and
+// whether the syntax of the content is highlighted (see patch/syntax.go)
+
+type DiffDisplayController struct {
+	baseController
+	*controllerCommon
+}
+
+var _ types.IController = &DiffDisplayController{}
+
+func NewDiffDisplayController(
+	common *controllerCommon,
+) *DiffDisplayController {
+	return &DiffDisplayController{
+		baseController:   baseController{},
+		controllerCommon: common,
+	}
+}
+
+func (self *DiffDisplayController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleWordDiff),
+			Handler:     self.CycleWordDiff,
+			Description: self.c.Tr.ToggleWordDiff,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleSideBySideDiff),
+			Handler:     self.ToggleSideBySide,
+			Description: self.c.Tr.ToggleSideBySideDiff,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleSyntaxHighlighting),
+			Handler:     self.ToggleSyntaxHighlighting,
+			Description: self.c.Tr.ToggleSyntaxHighlighting,
+		},
+	}
+
+	return bindings
+}
+
+func (self *DiffDisplayController) Context() types.Context {
+	return nil
+}
+
+func (self *DiffDisplayController) CycleWordDiff() error {
+	if !self.isShowingDiff() {
+		return nil
+	}
+
+	switch self.c.UserConfig.Git.WordDiff {
+	case patch.WORD_DIFF_WORD:
+		self.c.UserConfig.Git.WordDiff = patch.WORD_DIFF_CHAR
+		self.c.Toast(self.c.Tr.WordDiffChar)
+	case patch.WORD_DIFF_CHAR:
+		self.c.UserConfig.Git.WordDiff = patch.WORD_DIFF_OFF
+		self.c.Toast(self.c.Tr.WordDiffOff)
+	default:
+		self.c.UserConfig.Git.WordDiff = patch.WORD_DIFF_WORD
+		self.c.Toast(self.c.Tr.WordDiffWord)
+	}
+
+	return self.rerender()
+}
+
+func (self *DiffDisplayController) ToggleSideBySide() error {
+	if !self.isShowingDiff() {
+		return nil
+	}
+
+	self.c.UserConfig.Git.SideBySideDiff = !self.c.UserConfig.Git.SideBySideDiff
+
+	return self.rerender()
+}
+
+func (self *DiffDisplayController) ToggleSyntaxHighlighting() error {
+	if !self.isShowingDiff() {
+		return nil
+	}
+
+	self.c.UserConfig.Git.SyntaxHighlighting = !self.c.UserConfig.Git.SyntaxHighlighting
+
+	return self.rerender()
+}
+
+func (self *DiffDisplayController) rerender() error {
+	currentContext := self.c.CurrentStaticContext()
+	switch currentContext.GetKey() {
+	// the staging and patch building contexts render their diffs themselves
+	case context.PATCH_BUILDING_MAIN_CONTEXT_KEY:
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.PATCH_BUILDING}})
+	case context.STAGING_MAIN_CONTEXT_KEY, context.STAGING_SECONDARY_CONTEXT_KEY:
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STAGING}})
+	default:
+		return currentContext.HandleRenderToMain()
+	}
+}
+
+func (self *DiffDisplayController) isShowingDiff() bool {
+	return lo.Contains(
+		CONTEXT_KEYS_SHOWING_DIFFS,
+		self.c.CurrentStaticContext().GetKey(),
+	)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -136,6 +137,12 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Handler:     self.helpers.WorkingTree.OpenMergeTool,
 			Description: self.c.Tr.LcOpenMergeTool,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ResolveConflict),
+			Handler:     self.resolveConflict,
+			Description: self.c.Tr.LcResolveConflict,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.Fetch),
 			Handler:     self.fetch,
@@ -322,7 +329,7 @@ func (self *FilesController) pressWithLock(node *filetree.FileNode) error {
 }
 
 func (self *FilesController) press(node *filetree.FileNode) error {
-	if node.IsFile() && node.File.HasInlineMergeConflicts {
+	if node.IsFile() && self.helpers.MergeConflicts.HasConflictMarkers(node.File) {
 		return self.switchToMerge()
 	}
 
@@ -386,11 +393,11 @@ func (self *FilesController) EnterFile(opts types.OnFocusOpts) error {
 		return self.enterSubmodule(submoduleConfig)
 	}
 
-	if file.HasInlineMergeConflicts {
+	if self.helpers.MergeConflicts.HasConflictMarkers(file) {
 		return self.switchToMerge()
 	}
 	if file.HasMergeConflicts {
-		return self.c.ErrorMsg(self.c.Tr.FileStagingRequirements)
+		return self.helpers.MergeConflicts.CreateWholeFileConflictMenu(file)
 	}
 
 	return self.c.PushContext(self.contexts.Staging, opts)
@@ -614,6 +621,8 @@ func (self *FilesController) HandleCommitPress() error {
 	savedCommitMessage := self.getSavedCommitMessage()
 	if len(savedCommitMessage) > 0 {
 		self.setCommitMessage(savedCommitMessage)
+	} else if self.helpers.SplitCommit.Active() {
+		self.setCommitMessage(self.helpers.SplitCommit.OriginalMessage())
 	} else {
 		commitPrefixConfig := self.commitPrefixConfigForRepo()
 		if commitPrefixConfig != nil {
@@ -695,28 +704,34 @@ func (self *FilesController) HandleCommitEditorPress() error {
 }
 
 func (self *FilesController) handleStatusFilterPressed() error {
-	return self.c.Menu(types.CreateMenuOptions{
-		Title: self.c.Tr.FilteringMenuTitle,
-		Items: []*types.MenuItem{
-			{
-				Label: self.c.Tr.FilterStagedFiles,
-				OnPress: func() error {
-					return self.setStatusFiltering(filetree.DisplayStaged)
-				},
+	items := []*types.MenuItem{
+		{
+			Label: self.c.Tr.FilterStagedFiles,
+			OnPress: func() error {
+				return self.setStatusFiltering(filetree.DisplayStaged)
 			},
-			{
-				Label: self.c.Tr.FilterUnstagedFiles,
-				OnPress: func() error {
-					return self.setStatusFiltering(filetree.DisplayUnstaged)
-				},
+		},
+		{
+			Label: self.c.Tr.FilterUnstagedFiles,
+			OnPress: func() error {
+				return self.setStatusFiltering(filetree.DisplayUnstaged)
 			},
-			{
-				Label: self.c.Tr.ResetCommitFilterState,
-				OnPress: func() error {
-					return self.setStatusFiltering(filetree.DisplayAll)
-				},
+		},
+		{
+			Label: self.c.Tr.ResetCommitFilterState,
+			OnPress: func() error {
+				self.context().FileTreeViewModel.SetFilter(filetree.DisplayAll)
+				return self.helpers.FileFilter.SetFilter(helpers.FILE_FILTER_PANEL_FILES, filetree.FileFilter{})
 			},
 		},
+	}
+	// the path and change type filters come after the reset item so that the
+	// original items keep their positions
+	items = append(items, self.helpers.FileFilter.MenuItems(helpers.FILE_FILTER_PANEL_FILES)...)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.FilteringMenuTitle,
+		Items: items,
 	})
 }
 
@@ -742,6 +757,15 @@ func (self *FilesController) Open() error {
 	return self.helpers.Files.OpenFile(node.GetPath())
 }
 
+func (self *FilesController) resolveConflict() error {
+	file := self.getSelectedFile()
+	if file == nil || !file.HasMergeConflicts {
+		return nil
+	}
+
+	return self.helpers.MergeConflicts.CreateWholeFileConflictMenu(file)
+}
+
 func (self *FilesController) switchToMerge() error {
 	file := self.getSelectedFile()
 	if file == nil {
@@ -1,9 +1,10 @@
 package controllers
 
 import (
-	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -39,68 +40,98 @@ func (self *GitFlowController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 }
 
 func (self *GitFlowController) handleCreateGitFlowMenu(branch *models.Branch) error {
-	if !self.git.Flow.GitFlowEnabled() {
-		return self.c.ErrorMsg("You need to install git-flow and enable it in this repo to use git-flow features")
+	branchTypes := self.git.Flow.BranchTypes()
+	if len(branchTypes) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoBranchTypesConfigured)
 	}
 
-	startHandler := func(branchType string) func() error {
+	startHandler := func(branchType config.BranchTypeConfig) func() error {
 		return func() error {
-			title := utils.ResolvePlaceholderString(self.c.Tr.NewGitFlowBranchPrompt, map[string]string{"branchType": branchType})
+			title := utils.ResolvePlaceholderString(self.c.Tr.NewGitFlowBranchPrompt, map[string]string{"branchType": branchType.Name})
 
 			return self.c.Prompt(types.PromptOpts{
 				Title: title,
 				HandleConfirm: func(name string) error {
 					self.c.LogAction(self.c.Tr.Actions.GitFlowStart)
-					return self.c.RunSubprocessAndRefresh(
-						self.git.Flow.StartCmdObj(branchType, name),
-					)
+					if err := self.git.Flow.Start(branchType, name); err != nil {
+						return self.c.Error(err)
+					}
+
+					self.contexts.Branches.SetSelectedLineIdx(0)
+					return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
 				},
 			})
 		}
 	}
 
-	return self.c.Menu(types.CreateMenuOptions{
-		Title: "git flow",
-		Items: []*types.MenuItem{
-			{
-				// not localising here because it's one to one with the actual git flow commands
-				Label: fmt.Sprintf("finish branch '%s'", branch.Name),
-				OnPress: func() error {
-					return self.gitFlowFinishBranch(branch.Name)
-				},
-			},
-			{
-				Label:   "start feature",
-				OnPress: startHandler("feature"),
-				Key:     'f',
-			},
-			{
-				Label:   "start hotfix",
-				OnPress: startHandler("hotfix"),
-				Key:     'h',
-			},
-			{
-				Label:   "start bugfix",
-				OnPress: startHandler("bugfix"),
-				Key:     'b',
-			},
-			{
-				Label:   "start release",
-				OnPress: startHandler("release"),
-				Key:     'r',
+	menuItems := []*types.MenuItem{
+		{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.LcFinishBranch, map[string]string{"branchName": branch.Name}),
+			OnPress: func() error {
+				return self.gitFlowFinishBranch(branch.Name)
 			},
 		},
+	}
+
+	usedKeys := map[rune]bool{}
+	for _, branchType := range branchTypes {
+		var key types.Key
+		if branchType.Name != "" {
+			firstRune := []rune(branchType.Name)[0]
+			if !usedKeys[firstRune] {
+				usedKeys[firstRune] = true
+				key = firstRune
+			}
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   utils.ResolvePlaceholderString(self.c.Tr.LcStartBranchOfType, map[string]string{"branchType": branchType.Name}),
+			OnPress: startHandler(branchType),
+			Key:     key,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.BranchingModelTitle,
+		Items: menuItems,
 	})
 }
 
 func (self *GitFlowController) gitFlowFinishBranch(branchName string) error {
-	cmdObj, err := self.git.Flow.FinishCmdObj(branchName)
-	if err != nil {
-		return self.c.Error(err)
+	branchType, ok := self.git.Flow.BranchTypeFor(branchName)
+	if !ok {
+		return self.c.ErrorMsg(self.c.Tr.NotAGitFlowBranch)
 	}
 
-	self.c.LogAction(self.c.Tr.Actions.GitFlowFinish)
-	return self.c.RunSubprocessAndRefresh(cmdObj)
+	prompt := utils.ResolvePlaceholderString(
+		self.c.Tr.FinishBranchPrompt,
+		map[string]string{
+			"branchName":   branchName,
+			"mergeTargets": strings.Join(branchType.MergeInto, ", "),
+		},
+	)
+	if branchType.Tag && len(branchType.MergeInto) > 0 {
+		prompt += " " + utils.ResolvePlaceholderString(
+			self.c.Tr.FinishBranchTagNote,
+			map[string]string{
+				"mergeTarget": branchType.MergeInto[0],
+				"tagName":     self.git.Flow.TagName(branchType, branchName),
+			},
+		)
+	}
+	if branchType.DeleteAfterFinish {
+		prompt += " " + self.c.Tr.FinishBranchDeleteNote
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.FinishBranchTitle,
+		Prompt: prompt,
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.GitFlowFinish)
+			err := self.git.Flow.Finish(branchName)
+			return self.helpers.MergeAndRebase.CheckMergeOrRebase(err)
+		},
+	})
 }
 
 func (self *GitFlowController) checkSelected(callback func(*models.Branch) error) func() error {
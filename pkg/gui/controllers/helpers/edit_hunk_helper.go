@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Editing a hunk works like 'git add -e': we write the hunk to a temporary patch
+// file for the user to edit in their editor, then recount the header of what
+// they leave us with, check that it still applies to the index, and apply it.
+
+type EditHunkHelper struct {
+	c   *types.HelperCommon
+	git *commands.GitCommand
+	os  *oscommands.OSCommand
+}
+
+func NewEditHunkHelper(
+	c *types.HelperCommon,
+	git *commands.GitCommand,
+	os *oscommands.OSCommand,
+) *EditHunkHelper {
+	return &EditHunkHelper{
+		c:   c,
+		git: git,
+		os:  os,
+	}
+}
+
+// EditAndApply opens the patch of a single hunk of the file at the given path in
+// the user's editor, with the cursor on the given line of the hunk, and applies
+// the edited hunk to the index
+func (self *EditHunkHelper) EditAndApply(path string, patchText string, lineIdxInHunk int) error {
+	patchFilepath, err := self.git.WorkingTree.SaveTemporaryPatch(patchText + self.c.Tr.EditHunkInstructions)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	// the hunk comes after the file's header, which is more than the usual two
+	// lines for e.g. a new or renamed file
+	headerLength := strings.Count(patchText[:strings.Index(patchText, "\n@@")+1], "\n")
+	cmdStr, err := self.git.File.GetEditCmdStr(patchFilepath, headerLength+lineIdxInHunk+1)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.EditHunk)
+	if ok, err := self.c.RunSubprocess(self.os.Cmd.NewShell(cmdStr)); err != nil || !ok {
+		return err
+	}
+
+	editedPatchText, err := self.git.File.Cat(patchFilepath)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	newPatchText := patch.RecountEditedPatch(self.c.Log, path, editedPatchText)
+	if newPatchText == "" {
+		return nil
+	}
+
+	newPatchFilepath, err := self.git.WorkingTree.SaveTemporaryPatch(newPatchText)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if err := self.git.WorkingTree.ApplyPatchFile(newPatchFilepath, "check", "cached"); err != nil {
+		return self.c.ErrorMsg(utils.ResolvePlaceholderString(
+			self.c.Tr.EditedHunkDoesNotApply,
+			map[string]string{"error": strings.TrimSpace(err.Error())},
+		))
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.ApplyPatch)
+	if err := self.git.WorkingTree.ApplyPatchFile(newPatchFilepath, "cached"); err != nil {
+		return self.c.Error(err)
+	}
+
+	return nil
+}
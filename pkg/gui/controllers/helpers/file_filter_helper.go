@@ -0,0 +1,262 @@
+package helpers
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// The files panel and the commit files panel can each be filtered by path and by
+// the kind of change made to a file. We remember the filters of each repo in the
+// app state so that they're still there next time the repo is opened.
+
+const (
+	FILE_FILTER_PANEL_FILES        = "files"
+	FILE_FILTER_PANEL_COMMIT_FILES = "commitFiles"
+)
+
+type FileFilterHelper struct {
+	c        *types.HelperCommon
+	contexts *context.ContextTree
+}
+
+func NewFileFilterHelper(
+	c *types.HelperCommon,
+	contexts *context.ContextTree,
+) *FileFilterHelper {
+	return &FileFilterHelper{
+		c:        c,
+		contexts: contexts,
+	}
+}
+
+// MenuItems returns the items for filtering the given panel by path and change
+// type, for inclusion in the panel's filtering menu
+func (self *FileFilterHelper) MenuItems(panel string) []*types.MenuItem {
+	pathLabel := self.c.Tr.FilterFilesByPath
+	if text := self.GetFilter(panel).Text; text != "" {
+		pathLabel = fmt.Sprintf("%s (%s)", pathLabel, text)
+	}
+
+	return []*types.MenuItem{
+		{
+			Label:   pathLabel,
+			OnPress: func() error { return self.promptForPath(panel) },
+		},
+		{
+			Label:   self.c.Tr.FilterFilesByChangeType,
+			OnPress: func() error { return self.changeTypeMenu(panel) },
+		},
+	}
+}
+
+// OpenMenu shows the filtering menu of a panel that has no other kinds of filter
+func (self *FileFilterHelper) OpenMenu(panel string) error {
+	items := append(self.MenuItems(panel), &types.MenuItem{
+		Label: self.c.Tr.ResetCommitFilterState,
+		OnPress: func() error {
+			return self.SetFilter(panel, filetree.FileFilter{})
+		},
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.FilteringMenuTitle,
+		Items: items,
+	})
+}
+
+func (self *FileFilterHelper) promptForPath(panel string) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.FilterFilesByPathPrompt,
+		InitialContent: self.GetFilter(panel).Text,
+		HandleConfirm: func(text string) error {
+			filter := self.GetFilter(panel)
+			filter.Text = text
+			return self.SetFilter(panel, filter)
+		},
+	})
+}
+
+// changeTypeMenu shows a checkbox for each change type. Toggling one shows the
+// menu again so that several can be combined
+func (self *FileFilterHelper) changeTypeMenu(panel string) error {
+	filter := self.GetFilter(panel)
+
+	items := slices.Map(filetree.AllChangeTypes, func(changeType filetree.ChangeType) *types.MenuItem {
+		checkbox := "[ ]"
+		if lo.Contains(filter.ChangeTypes, changeType) {
+			checkbox = "[x]"
+		}
+
+		return &types.MenuItem{
+			Label: fmt.Sprintf("%s %s", checkbox, self.changeTypeLabel(changeType)),
+			OnPress: func() error {
+				newFilter := filter
+				if lo.Contains(filter.ChangeTypes, changeType) {
+					newFilter.ChangeTypes = slices.Filter(filter.ChangeTypes, func(t filetree.ChangeType) bool {
+						return t != changeType
+					})
+				} else {
+					newFilter.ChangeTypes = append(slices.Clone(filter.ChangeTypes), changeType)
+				}
+
+				if err := self.SetFilter(panel, newFilter); err != nil {
+					return err
+				}
+
+				return self.changeTypeMenu(panel)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.FilterFilesByChangeType,
+		Items: items,
+	})
+}
+
+func (self *FileFilterHelper) changeTypeLabel(changeType filetree.ChangeType) string {
+	switch changeType {
+	case filetree.CHANGE_TYPE_ADDED:
+		return self.c.Tr.ChangeTypeAdded
+	case filetree.CHANGE_TYPE_MODIFIED:
+		return self.c.Tr.ChangeTypeModified
+	case filetree.CHANGE_TYPE_DELETED:
+		return self.c.Tr.ChangeTypeDeleted
+	case filetree.CHANGE_TYPE_RENAMED:
+		return self.c.Tr.ChangeTypeRenamed
+	case filetree.CHANGE_TYPE_UNTRACKED:
+		return self.c.Tr.ChangeTypeUntracked
+	case filetree.CHANGE_TYPE_CONFLICTED:
+		return self.c.Tr.ChangeTypeConflicted
+	default:
+		return string(changeType)
+	}
+}
+
+// Active tells us whether either panel is filtered, in which case we show the
+// filters at the bottom of the screen like the other modes
+func (self *FileFilterHelper) Active() bool {
+	return !self.GetFilter(FILE_FILTER_PANEL_FILES).IsEmpty() ||
+		!self.GetFilter(FILE_FILTER_PANEL_COMMIT_FILES).IsEmpty()
+}
+
+// Description describes the filter of each filtered panel e.g.
+// "Files: '*.go', added; Commit files: deleted"
+func (self *FileFilterHelper) Description() string {
+	panelTitles := map[string]string{
+		FILE_FILTER_PANEL_FILES:        self.c.Tr.FilesTitle,
+		FILE_FILTER_PANEL_COMMIT_FILES: self.c.Tr.CommitFiles,
+	}
+
+	descriptions := []string{}
+	for _, panel := range []string{FILE_FILTER_PANEL_FILES, FILE_FILTER_PANEL_COMMIT_FILES} {
+		filter := self.GetFilter(panel)
+		if filter.IsEmpty() {
+			continue
+		}
+
+		parts := slices.Map(filter.ChangeTypes, self.changeTypeLabel)
+		if filter.Text != "" {
+			parts = append([]string{fmt.Sprintf("'%s'", filter.Text)}, parts...)
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", panelTitles[panel], strings.Join(parts, ", ")))
+	}
+
+	return strings.Join(descriptions, "; ")
+}
+
+// Reset clears the filters of both panels
+func (self *FileFilterHelper) Reset() error {
+	for _, panel := range []string{FILE_FILTER_PANEL_FILES, FILE_FILTER_PANEL_COMMIT_FILES} {
+		if err := self.SetFilter(panel, filetree.FileFilter{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *FileFilterHelper) GetFilter(panel string) filetree.FileFilter {
+	switch panel {
+	case FILE_FILTER_PANEL_FILES:
+		return self.contexts.Files.FileTreeViewModel.GetFileFilter()
+	case FILE_FILTER_PANEL_COMMIT_FILES:
+		return self.contexts.CommitFiles.CommitFileTreeViewModel.GetFileFilter()
+	default:
+		panic(fmt.Sprintf("Unexpected file filter panel: %s", panel))
+	}
+}
+
+// SetFilter applies the filter to the panel and remembers it for the current repo
+func (self *FileFilterHelper) SetFilter(panel string, filter filetree.FileFilter) error {
+	self.applyFilter(panel, filter)
+
+	if err := self.saveFilter(panel, filter); err != nil {
+		return self.c.Error(err)
+	}
+
+	if panel == FILE_FILTER_PANEL_FILES {
+		return self.c.PostRefreshUpdate(self.contexts.Files)
+	}
+	return self.c.PostRefreshUpdate(self.contexts.CommitFiles)
+}
+
+// RestoreFilters applies the filters last used in the current repo
+func (self *FileFilterHelper) RestoreFilters() {
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return
+	}
+
+	for _, panel := range []string{FILE_FILTER_PANEL_FILES, FILE_FILTER_PANEL_COMMIT_FILES} {
+		savedFilter := self.c.GetAppState().FileFilters[repoPath][panel]
+		self.applyFilter(panel, filetree.FileFilter{
+			Text: savedFilter.Text,
+			ChangeTypes: slices.Map(savedFilter.ChangeTypes, func(changeType string) filetree.ChangeType {
+				return filetree.ChangeType(changeType)
+			}),
+		})
+	}
+}
+
+func (self *FileFilterHelper) applyFilter(panel string, filter filetree.FileFilter) {
+	switch panel {
+	case FILE_FILTER_PANEL_FILES:
+		self.contexts.Files.FileTreeViewModel.SetFileFilter(filter)
+	case FILE_FILTER_PANEL_COMMIT_FILES:
+		self.contexts.CommitFiles.CommitFileTreeViewModel.SetFileFilter(filter)
+	default:
+		panic(fmt.Sprintf("Unexpected file filter panel: %s", panel))
+	}
+}
+
+func (self *FileFilterHelper) saveFilter(panel string, filter filetree.FileFilter) error {
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	appState := self.c.GetAppState()
+	if appState.FileFilters == nil {
+		appState.FileFilters = map[string]map[string]config.FileFilter{}
+	}
+	if appState.FileFilters[repoPath] == nil {
+		appState.FileFilters[repoPath] = map[string]config.FileFilter{}
+	}
+	appState.FileFilters[repoPath][panel] = config.FileFilter{
+		Text: filter.Text,
+		ChangeTypes: slices.Map(filter.ChangeTypes, func(changeType filetree.ChangeType) string {
+			return string(changeType)
+		}),
+	}
+
+	return self.c.SaveAppState()
+}
@@ -14,6 +14,9 @@ type Helpers struct {
 	PatchBuilding  *PatchBuildingHelper
 	GPG            *GpgHelper
 	Upstream       *UpstreamHelper
+	SplitCommit    *SplitCommitHelper
+	EditHunk       *EditHunkHelper
+	FileFilter     *FileFilterHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -31,5 +34,8 @@ func NewStubHelpers() *Helpers {
 		PatchBuilding:  &PatchBuildingHelper{},
 		GPG:            &GpgHelper{},
 		Upstream:       &UpstreamHelper{},
+		SplitCommit:    &SplitCommitHelper{},
+		EditHunk:       &EditHunkHelper{},
+		FileFilter:     &FileFilterHelper{},
 	}
 }
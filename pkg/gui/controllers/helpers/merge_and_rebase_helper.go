@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -18,6 +19,8 @@ type MergeAndRebaseHelper struct {
 	contexts   *context.ContextTree
 	git        *commands.GitCommand
 	refsHelper *RefsHelper
+	// used to pre-fill the commit message panel after a squash merge
+	setCommitMessage func(message string)
 }
 
 func NewMergeAndRebaseHelper(
@@ -25,12 +28,14 @@ func NewMergeAndRebaseHelper(
 	contexts *context.ContextTree,
 	git *commands.GitCommand,
 	refsHelper *RefsHelper,
+	setCommitMessage func(message string),
 ) *MergeAndRebaseHelper {
 	return &MergeAndRebaseHelper{
-		c:          c,
-		contexts:   contexts,
-		git:        git,
-		refsHelper: refsHelper,
+		c:                c,
+		contexts:         contexts,
+		git:              git,
+		refsHelper:       refsHelper,
+		setCommitMessage: setCommitMessage,
 	}
 }
 
@@ -69,6 +74,25 @@ func (self *MergeAndRebaseHelper) CreateRebaseOptionsMenu() error {
 		}
 	})
 
+	if self.canInsertRebaseTodos() {
+		menuItems = append(menuItems,
+			&types.MenuItem{
+				Label:   self.c.Tr.LcInsertExecTodo,
+				OnPress: self.promptForExecTodo,
+				Key:     'x',
+				Tooltip: self.c.Tr.InsertExecTodoTooltip,
+			},
+			&types.MenuItem{
+				Label: self.c.Tr.LcInsertBreakTodo,
+				OnPress: func() error {
+					return self.insertRebaseTodo("break")
+				},
+				Key:     'b',
+				Tooltip: self.c.Tr.InsertBreakTodoTooltip,
+			},
+		)
+	}
+
 	var title string
 	if self.git.Status.WorkingTreeState() == enums.REBASE_MODE_MERGING {
 		title = self.c.Tr.MergeOptionsTitle
@@ -79,6 +103,49 @@ func (self *MergeAndRebaseHelper) CreateRebaseOptionsMenu() error {
 	return self.c.Menu(types.CreateMenuOptions{Title: title, Items: menuItems})
 }
 
+// we can only add todos to an interactive rebase, and we add them after the selected
+// commit so we need to be in the commits panel
+func (self *MergeAndRebaseHelper) canInsertRebaseTodos() bool {
+	rebaseMode, _ := self.git.Status.RebaseMode()
+	return rebaseMode == enums.REBASE_MODE_INTERACTIVE &&
+		self.c.CurrentContext().GetKey() == self.contexts.LocalCommits.GetKey()
+}
+
+func (self *MergeAndRebaseHelper) promptForExecTodo() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.ExecTodoPromptTitle,
+		HandleConfirm: func(command string) error {
+			return self.insertRebaseTodo("exec " + command)
+		},
+	})
+}
+
+func (self *MergeAndRebaseHelper) insertRebaseTodo(line string) error {
+	commits := self.contexts.LocalCommits.GetItems()
+	index := self.contexts.LocalCommits.GetSelectedLineIdx()
+
+	// the todos are at the top of the list, followed by the commit we're currently
+	// stopped at. Anything below that has already been applied.
+	pendingCount := 0
+	for pendingCount < len(commits) && commits[pendingCount].IsTODO() {
+		pendingCount++
+	}
+	if index > pendingCount {
+		return self.c.ErrorMsg(self.c.Tr.CannotInsertTodoAfterAppliedCommit)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.InsertRebaseTodo)
+	self.c.LogCommand(fmt.Sprintf("Inserting '%s' into rebase TODO", line), false)
+
+	if err := self.git.Rebase.InsertRebaseTodo(index, line); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{
+		Mode: types.SYNC, Scope: []types.RefreshableView{types.REBASE_COMMITS},
+	})
+}
+
 func (self *MergeAndRebaseHelper) genericMergeCommand(command string) error {
 	status := self.git.Status.WorkingTreeState()
 
@@ -132,6 +199,14 @@ func isMergeConflictErr(errStr string) bool {
 }
 
 func (self *MergeAndRebaseHelper) CheckMergeOrRebase(result error) error {
+	return self.CheckMergeOrRebaseWithConflictHandler(result, func() error {
+		return self.c.PushContext(self.contexts.Files)
+	})
+}
+
+// CheckMergeOrRebaseWithConflictHandler is like CheckMergeOrRebase except that the
+// caller decides where to take the user when they choose to resolve conflicts
+func (self *MergeAndRebaseHelper) CheckMergeOrRebaseWithConflictHandler(result error, onResolveConflicts func() error) error {
 	if err := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); err != nil {
 		return err
 	}
@@ -146,11 +221,9 @@ func (self *MergeAndRebaseHelper) CheckMergeOrRebase(result error) error {
 		return nil
 	} else if isMergeConflictErr(result.Error()) {
 		return self.c.Confirm(types.ConfirmOpts{
-			Title:  self.c.Tr.FoundConflictsTitle,
-			Prompt: self.c.Tr.FoundConflicts,
-			HandleConfirm: func() error {
-				return self.c.PushContext(self.contexts.Files)
-			},
+			Title:         self.c.Tr.FoundConflictsTitle,
+			Prompt:        self.c.Tr.FoundConflicts,
+			HandleConfirm: onResolveConflicts,
 			HandleClose: func() error {
 				return self.genericMergeCommand(REBASE_OPTION_ABORT)
 			},
@@ -227,21 +300,176 @@ func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) e
 	if checkedOutBranchName == refName {
 		return self.c.ErrorMsg(self.c.Tr.CantMergeBranchIntoItself)
 	}
-	prompt := utils.ResolvePlaceholderString(
-		self.c.Tr.ConfirmMerge,
+
+	return self.createMergeMenu(refName, checkedOutBranchName, mergeStrategy{})
+}
+
+// the strategy picked in the merge menu. Picking it re-opens the menu so that
+// the user can then choose how to merge.
+type mergeStrategy struct {
+	name    string
+	options []string
+}
+
+func (self *MergeAndRebaseHelper) createMergeMenu(refName string, checkedOutBranchName string, strategy mergeStrategy) error {
+	merge := func(opts git_commands.MergeOpts) error {
+		opts.Strategy = strategy.name
+		opts.StrategyOptions = strategy.options
+		self.c.LogAction(self.c.Tr.Actions.Merge)
+		err := self.git.Branch.Merge(refName, opts)
+		return self.CheckMergeOrRebase(err)
+	}
+
+	strategyName := strategy.name
+	if strategyName == "" {
+		strategyName = self.c.Tr.LcDefault
+	}
+
+	title := utils.ResolvePlaceholderString(
+		self.c.Tr.MergeMenuTitle,
 		map[string]string{
 			"checkedOutBranch": checkedOutBranchName,
 			"selectedBranch":   refName,
 		},
 	)
 
-	return self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.MergeConfirmTitle,
-		Prompt: prompt,
-		HandleConfirm: func() error {
-			self.c.LogAction(self.c.Tr.Actions.Merge)
-			err := self.git.Branch.Merge(refName, git_commands.MergeOpts{})
-			return self.CheckMergeOrRebase(err)
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: title,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.LcRegularMerge,
+				OnPress: func() error {
+					return merge(git_commands.MergeOpts{})
+				},
+				Key: 'm',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcMergeNoFastForward, style.FgYellow.Sprint("--no-ff")},
+				OnPress: func() error {
+					return merge(git_commands.MergeOpts{NoFastForward: true})
+				},
+				Key: 'n',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcMergeFastForwardOnly, style.FgYellow.Sprint("--ff-only")},
+				OnPress: func() error {
+					return merge(git_commands.MergeOpts{FastForwardOnly: true})
+				},
+				Key: 'f',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcSquashMerge, style.FgYellow.Sprint("--squash")},
+				OnPress: func() error {
+					return self.squashMerge(refName, strategy)
+				},
+				Key: 's',
+			},
+			{
+				Label: self.c.Tr.LcMergeWithCustomMessage,
+				OnPress: func() error {
+					return self.c.Prompt(types.PromptOpts{
+						Title:          self.c.Tr.MergeMessageTitle,
+						InitialContent: fmt.Sprintf("Merge branch '%s' into %s", refName, checkedOutBranchName),
+						HandleConfirm: func(message string) error {
+							return merge(git_commands.MergeOpts{Message: message})
+						},
+					})
+				},
+				Key: 'c',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcMergeStrategy, style.FgCyan.Sprint(strategyName)},
+				OnPress: func() error {
+					return self.createMergeStrategyMenu(refName, checkedOutBranchName, strategy)
+				},
+				Key:       'S',
+				OpensMenu: true,
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcMergeStrategyOptions, style.FgCyan.Sprint(strings.Join(strategy.options, " "))},
+				OnPress: func() error {
+					return self.c.Prompt(types.PromptOpts{
+						Title:          self.c.Tr.MergeStrategyOptionsTitle,
+						InitialContent: strings.Join(strategy.options, " "),
+						HandleConfirm: func(options string) error {
+							strategy.options = strings.Fields(options)
+							return self.createMergeMenu(refName, checkedOutBranchName, strategy)
+						},
+					})
+				},
+				Key: 'X',
+			},
 		},
 	})
 }
+
+func (self *MergeAndRebaseHelper) createMergeStrategyMenu(refName string, checkedOutBranchName string, strategy mergeStrategy) error {
+	menuItems := slices.Map([]string{"", "ort", "recursive", "resolve", "ours"}, func(name string) *types.MenuItem {
+		label := name
+		if name == "" {
+			label = self.c.Tr.LcDefault
+		}
+
+		return &types.MenuItem{
+			Label: label,
+			OnPress: func() error {
+				strategy.name = name
+				return self.createMergeMenu(refName, checkedOutBranchName, strategy)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.MergeStrategyTitle, Items: menuItems})
+}
+
+// squashMerge stages the changes from the branch and then opens the commit message
+// panel, pre-filled with the subjects of the commits being squashed
+func (self *MergeAndRebaseHelper) squashMerge(refName string, strategy mergeStrategy) error {
+	subjects, err := self.git.Branch.SubjectsToMerge(refName)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.SquashMerge)
+	err = self.git.Branch.Merge(refName, git_commands.MergeOpts{
+		Squash:          true,
+		Strategy:        strategy.name,
+		StrategyOptions: strategy.options,
+	})
+	if err != nil {
+		if !isMergeConflictErr(err.Error()) {
+			return self.CheckMergeOrRebase(err)
+		}
+
+		// A conflicted squash merge leaves no MERGE_HEAD behind so there's no merge
+		// for us to abort. Instead we tell the user how to undo it themselves
+		self.setCommitMessage(squashMergeMessage(refName, subjects))
+		if err := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); err != nil {
+			return err
+		}
+
+		return self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.FoundConflictsTitle,
+			Prompt: self.c.Tr.SquashMergeConflicts,
+			HandleConfirm: func() error {
+				return self.c.PushContext(self.contexts.Files)
+			},
+		})
+	}
+
+	self.setCommitMessage(squashMergeMessage(refName, subjects))
+	if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}}); err != nil {
+		return err
+	}
+
+	return self.c.PushContext(self.contexts.CommitMessage)
+}
+
+func squashMergeMessage(refName string, subjects []string) string {
+	lines := []string{fmt.Sprintf("Squashed branch '%s'", refName), ""}
+	for _, subject := range subjects {
+		lines = append(lines, "* "+subject)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -2,11 +2,18 @@ package helpers
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/mergeconflicts"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type MergeConflictsHelper struct {
@@ -33,9 +40,10 @@ func (self *MergeConflictsHelper) GetMergingOptions() map[string]string {
 	return map[string]string{
 		fmt.Sprintf("%s %s", keybindings.Label(keybindingConfig.Universal.PrevItem), keybindings.Label(keybindingConfig.Universal.NextItem)):   self.c.Tr.LcSelectHunk,
 		fmt.Sprintf("%s %s", keybindings.Label(keybindingConfig.Universal.PrevBlock), keybindings.Label(keybindingConfig.Universal.NextBlock)): self.c.Tr.LcNavigateConflicts,
-		keybindings.Label(keybindingConfig.Universal.Select):   self.c.Tr.LcPickHunk,
-		keybindings.Label(keybindingConfig.Main.PickBothHunks): self.c.Tr.LcPickAllHunks,
-		keybindings.Label(keybindingConfig.Universal.Undo):     self.c.Tr.LcUndo,
+		keybindings.Label(keybindingConfig.Universal.Select):         self.c.Tr.LcPickHunk,
+		keybindings.Label(keybindingConfig.Main.PickBothHunks):       self.c.Tr.LcPickAllHunks,
+		keybindings.Label(keybindingConfig.Universal.Undo):           self.c.Tr.LcUndo,
+		keybindings.Label(keybindingConfig.Main.ToggleThreeWayMerge): self.c.Tr.LcToggleThreeWayMerge,
 	}
 }
 
@@ -58,9 +66,61 @@ func (self *MergeConflictsHelper) setMergeStateWithoutLock(path string) (bool, e
 
 	self.context().GetState().SetContent(content, path)
 
+	if self.context().GetState().ThreeWay() {
+		if err := self.LoadThreeWaySides(); err != nil {
+			return false, err
+		}
+	}
+
 	return !self.context().GetState().NoConflicts(), nil
 }
 
+// LoadThreeWaySides gets the base, ours and theirs versions of the current file's
+// conflicts for the three-way view, unless we've already got them
+func (self *MergeConflictsHelper) LoadThreeWaySides() error {
+	state := self.context().GetState()
+	if !state.NeedsThreeWaySides() {
+		return nil
+	}
+
+	content, err := self.git.WorkingTree.ThreeWayMerge(state.GetPath())
+	if err != nil {
+		return err
+	}
+
+	state.SetThreeWaySides(content)
+
+	return nil
+}
+
+// ThreeWayViewUpdateOpts returns what to show next to the file in the three-way
+// view, or nil if we're not in the three-way view
+func (self *MergeConflictsHelper) ThreeWayViewUpdateOpts() *types.ViewUpdateOpts {
+	if !self.context().GetState().ThreeWay() {
+		return nil
+	}
+
+	pair := self.c.MainViewPairs().MergeConflicts
+	width := pair.Secondary.GetView().Width()
+	if width <= 1 {
+		width = pair.Main.GetView().Width()
+	}
+
+	return &types.ViewUpdateOpts{
+		Title: self.c.Tr.ThreeWayMergeTitle,
+		Task:  types.NewRenderStringTask(mergeconflicts.ColoredThreeWaySides(self.context().GetState(), width)),
+	}
+}
+
+// RenderThreeWay shows or hides the three-way view next to the file, depending on
+// whether it's enabled
+func (self *MergeConflictsHelper) RenderThreeWay() error {
+	return self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair:      self.c.MainViewPairs().MergeConflicts,
+		Secondary: self.ThreeWayViewUpdateOpts(),
+	})
+}
+
 func (self *MergeConflictsHelper) ResetMergeState() {
 	self.context().GetMutex().Lock()
 	defer self.context().GetMutex().Unlock()
@@ -113,3 +173,210 @@ func (self *MergeConflictsHelper) SwitchToMerge(path string) error {
 func (self *MergeConflictsHelper) context() *context.MergeConflictsContext {
 	return self.contexts.MergeConflicts
 }
+
+// HasConflictMarkers tells us whether we can resolve the file's conflicts in the
+// merge conflicts view. Otherwise the conflict has to be resolved for the file as
+// a whole, e.g. because one side deleted the file or because it's binary.
+func (self *MergeConflictsHelper) HasConflictMarkers(file *models.File) bool {
+	if !file.HasInlineMergeConflicts {
+		return false
+	}
+
+	hasConflicts, err := mergeconflicts.FileHasConflictMarkers(file.Name)
+	if err != nil {
+		self.c.Log.Error(err)
+		return false
+	}
+
+	return hasConflicts
+}
+
+// ConflictExplanation describes a conflict that has to be resolved for the file as
+// a whole, going by its short status
+func (self *MergeConflictsHelper) ConflictExplanation(file *models.File) string {
+	descriptions := map[string]string{
+		"UU": self.c.Tr.ConflictModifiedByBoth,
+		"AA": self.c.Tr.ConflictAddedByBoth,
+		"DU": self.c.Tr.ConflictDeletedByUs,
+		"UD": self.c.Tr.ConflictDeletedByThem,
+		"DD": self.c.Tr.ConflictDeletedByBoth,
+		"AU": self.c.Tr.ConflictAddedByUs,
+		"UA": self.c.Tr.ConflictAddedByThem,
+	}
+
+	hint := utils.ResolvePlaceholderString(self.c.Tr.WholeFileConflictHint, map[string]string{
+		"key": keybindings.Label(self.c.UserConfig.Keybinding.Files.ResolveConflict),
+	})
+
+	return fmt.Sprintf("%s (%s)\n\n%s", descriptions[file.ShortStatus], file.ShortStatus, hint)
+}
+
+// CreateWholeFileConflictMenu offers the ways of resolving the file's conflict
+// that make sense for its type, e.g. keeping their modified version of a file that
+// we deleted, along with rerere's recorded resolutions
+func (self *MergeConflictsHelper) CreateWholeFileConflictMenu(file *models.File) error {
+	keepOurs := &types.MenuItem{
+		Label: self.c.Tr.LcKeepOurs,
+		OnPress: self.resolveWholeFileConflict(func() error {
+			return self.git.WorkingTree.ResolveConflictWithOurs(file.Name)
+		}),
+		Key: 'o',
+	}
+	keepTheirs := &types.MenuItem{
+		Label: self.c.Tr.LcKeepTheirs,
+		OnPress: self.resolveWholeFileConflict(func() error {
+			return self.git.WorkingTree.ResolveConflictWithTheirs(file.Name)
+		}),
+		Key: 't',
+	}
+	// for when only one side has the file, in which case that's the version in the
+	// working tree
+	keepFile := func(label string) *types.MenuItem {
+		return &types.MenuItem{
+			Label: label,
+			OnPress: self.resolveWholeFileConflict(func() error {
+				return self.git.WorkingTree.StageFile(file.Name)
+			}),
+			Key: 'k',
+		}
+	}
+	deleteFile := func(label string) *types.MenuItem {
+		return &types.MenuItem{
+			Label: label,
+			OnPress: self.resolveWholeFileConflict(func() error {
+				return self.git.WorkingTree.RemoveConflictedFiles([]string{file.Name})
+			}),
+			Key: 'd',
+		}
+	}
+
+	var menuItems []*types.MenuItem
+	switch file.ShortStatus {
+	case "UU", "AA":
+		menuItems = []*types.MenuItem{keepOurs, keepTheirs}
+	case "DU":
+		menuItems = []*types.MenuItem{keepFile(self.c.Tr.LcKeepTheirModifiedVersion), deleteFile(self.c.Tr.LcKeepDeleted)}
+	case "UD":
+		menuItems = []*types.MenuItem{keepFile(self.c.Tr.LcKeepOurModifiedVersion), deleteFile(self.c.Tr.LcKeepDeleted)}
+	case "AU", "UA":
+		menuItems = append([]*types.MenuItem{keepFile(self.c.Tr.LcKeepFile), deleteFile(self.c.Tr.LcDeleteFile)}, self.renameMenuItems(file)...)
+	case "DD":
+		menuItems = append([]*types.MenuItem{deleteFile(self.c.Tr.LcKeepDeleted)}, self.renameMenuItems(file)...)
+	}
+
+	if file.ResolvedByRerere {
+		menuItems = self.rerereResolutionMenuItems(file)
+	} else if self.HasConflictMarkers(file) && self.git.Rerere.HasRecordedResolution(file.Name) {
+		menuItems = append([]*types.MenuItem{{
+			Label:   self.c.Tr.LcApplyRerereResolution,
+			OnPress: self.runAndRefreshFiles(self.c.Tr.Actions.ApplyRerereResolution, self.git.Rerere.Apply),
+			Key:     'r',
+		}}, menuItems...)
+	}
+
+	menuItems = append(menuItems, self.toggleRerereMenuItem())
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: fmt.Sprintf("%s: %s", self.c.Tr.ResolveConflictMenuTitle, file.Name),
+		Items: menuItems,
+	})
+}
+
+// renameMenuItems handles a file that was renamed differently on each side, which
+// leaves its old path deleted by both (DD) and its new paths as added by us (AU)
+// and added by them (UA). Keeping one of the new paths means removing the others.
+func (self *MergeConflictsHelper) renameMenuItems(file *models.File) []*types.MenuItem {
+	renameConflicts, err := self.git.WorkingTree.RenameConflicts()
+	if err != nil {
+		self.c.Log.Error(err)
+		return nil
+	}
+
+	renameConflict, ok := lo.Find(renameConflicts, func(renameConflict git_commands.RenameConflict) bool {
+		return lo.Contains(renameConflict.Paths(), file.Name)
+	})
+	if !ok {
+		return nil
+	}
+
+	pathsToKeep := []string{renameConflict.OurPath, renameConflict.TheirPath}
+	if file.ShortStatus != "DD" {
+		pathsToKeep = []string{file.Name}
+	}
+
+	return slices.Map(pathsToKeep, func(pathToKeep string) *types.MenuItem {
+		pathsToRemove := slices.Filter(renameConflict.Paths(), func(path string) bool {
+			return path != pathToKeep
+		})
+
+		return &types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.LcKeepRenamedPath, map[string]string{
+				"path":    pathToKeep,
+				"removed": strings.Join(pathsToRemove, ", "),
+			}),
+			OnPress: self.resolveWholeFileConflict(func() error {
+				if err := self.git.WorkingTree.RemoveConflictedFiles(pathsToRemove); err != nil {
+					return err
+				}
+				return self.git.WorkingTree.StageFile(pathToKeep)
+			}),
+		}
+	})
+}
+
+// rerereResolutionMenuItems is for a file that rerere resolved with a recorded
+// resolution. If the user is happy with the result they can stage it, otherwise
+// they can forget the resolution and resolve the conflicts again themselves.
+func (self *MergeConflictsHelper) rerereResolutionMenuItems(file *models.File) []*types.MenuItem {
+	return []*types.MenuItem{
+		{
+			Label: self.c.Tr.LcStageRerereResolution,
+			OnPress: self.resolveWholeFileConflict(func() error {
+				return self.git.WorkingTree.StageFile(file.Name)
+			}),
+			Key: 's',
+		},
+		{
+			Label: self.c.Tr.LcForgetRerereResolution,
+			OnPress: self.runAndRefreshFiles(self.c.Tr.Actions.ForgetRerereResolution, func() error {
+				return self.git.Rerere.Forget(file.Name)
+			}),
+			Key: 'f',
+		},
+	}
+}
+
+func (self *MergeConflictsHelper) toggleRerereMenuItem() *types.MenuItem {
+	if self.git.Rerere.IsEnabled() {
+		return &types.MenuItem{
+			Label: self.c.Tr.LcDisableRerere,
+			OnPress: self.runAndRefreshFiles(self.c.Tr.Actions.DisableRerere, func() error {
+				return self.git.Rerere.SetEnabled(false)
+			}),
+			Key: 'e',
+		}
+	}
+
+	return &types.MenuItem{
+		Label: self.c.Tr.LcEnableRerere,
+		OnPress: self.runAndRefreshFiles(self.c.Tr.Actions.EnableRerere, func() error {
+			return self.git.Rerere.SetEnabled(true)
+		}),
+		Key: 'e',
+	}
+}
+
+func (self *MergeConflictsHelper) runAndRefreshFiles(action string, f func() error) func() error {
+	return func() error {
+		self.c.LogAction(action)
+		if err := f(); err != nil {
+			return self.c.Error(err)
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}})
+	}
+}
+
+func (self *MergeConflictsHelper) resolveWholeFileConflict(f func() error) func() error {
+	return self.runAndRefreshFiles(self.c.Tr.Actions.ResolveConflict, f)
+}
@@ -0,0 +1,130 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/splitting"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Splitting a commit works like so: we start an interactive rebase which stops
+// at the commit, then soft-reset it so that its changes are staged. The user
+// then goes through rounds of staging and committing using the usual files,
+// staging, and commit message panels. After each commit we check whether
+// there's anything left and if not, we continue the rebase. Because the whole
+// thing happens inside a single rebase, undoing it (via the reflog) undoes
+// the entire split.
+
+type SplitCommitHelper struct {
+	c            *types.HelperCommon
+	git          *commands.GitCommand
+	contexts     *context.ContextTree
+	model        *types.Model
+	getData      func() *splitting.Splitting
+	rebaseHelper *MergeAndRebaseHelper
+}
+
+func NewSplitCommitHelper(
+	c *types.HelperCommon,
+	git *commands.GitCommand,
+	contexts *context.ContextTree,
+	model *types.Model,
+	getData func() *splitting.Splitting,
+	rebaseHelper *MergeAndRebaseHelper,
+) *SplitCommitHelper {
+	return &SplitCommitHelper{
+		c:            c,
+		git:          git,
+		contexts:     contexts,
+		model:        model,
+		getData:      getData,
+		rebaseHelper: rebaseHelper,
+	}
+}
+
+func (self *SplitCommitHelper) Start(commits []*models.Commit, index int) error {
+	commit := commits[index]
+	message, err := self.git.Commit.GetCommitMessage(commit.Sha)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	untrackedFiles := slices.FilterMap(self.model.Files, func(file *models.File) (string, bool) {
+		return file.Name, !file.Tracked
+	})
+
+	return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func() error {
+		self.c.LogAction(self.c.Tr.Actions.SplitCommit)
+		if err := self.git.Rebase.BeginSplitCommit(commits, index); err != nil {
+			return self.rebaseHelper.CheckMergeOrRebase(err)
+		}
+
+		self.getData().Start(commit, message, untrackedFiles)
+
+		if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC}); err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.c.PushContext(self.contexts.Files)
+		})
+
+		return nil
+	})
+}
+
+// Active tells us whether we're in the middle of splitting a commit. If the
+// user has ended the rebase themselves (e.g. by aborting it) we're no longer splitting.
+func (self *SplitCommitHelper) Active() bool {
+	if !self.getData().Active() {
+		return false
+	}
+
+	if self.git.Status.WorkingTreeState() == enums.REBASE_MODE_NONE {
+		self.getData().Reset()
+		return false
+	}
+
+	return true
+}
+
+func (self *SplitCommitHelper) OriginalMessage() string {
+	return self.getData().OriginalMessage
+}
+
+func (self *SplitCommitHelper) Commit() *models.Commit {
+	return self.getData().Commit
+}
+
+// AfterCommit is to be called whenever a commit is made. If we're splitting a
+// commit and there's nothing left to commit, we continue the rebase.
+func (self *SplitCommitHelper) AfterCommit() error {
+	if !self.Active() {
+		return nil
+	}
+
+	if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}}); err != nil {
+		return err
+	}
+
+	if self.anyChangesLeft() {
+		self.c.Toast(self.c.Tr.SplitCommitNextPart)
+		return nil
+	}
+
+	self.getData().Reset()
+
+	self.c.LogAction(self.c.Tr.Actions.SplitCommit)
+	return self.rebaseHelper.CheckMergeOrRebase(self.git.Rebase.ContinueRebase())
+}
+
+func (self *SplitCommitHelper) anyChangesLeft() bool {
+	preExistingUntrackedFiles := self.getData().PreExistingUntrackedFiles
+
+	return slices.Some(self.model.Files, func(file *models.File) bool {
+		return file.Tracked || !preExistingUntrackedFiles.Includes(file.Name)
+	})
+}
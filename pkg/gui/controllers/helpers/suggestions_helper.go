@@ -29,6 +29,7 @@ type ISuggestionsHelper interface {
 	GetBranchNameSuggestionsFunc() func(string) []*types.Suggestion
 	GetFilePathSuggestionsFunc() func(string) []*types.Suggestion
 	GetRemoteBranchesSuggestionsFunc(separator string) func(string) []*types.Suggestion
+	GetRefspecSuggestionsFunc(remoteName string) func(string) []*types.Suggestion
 	GetRefsSuggestionsFunc() func(string) []*types.Suggestion
 }
 
@@ -158,6 +159,22 @@ func (self *SuggestionsHelper) GetRemoteBranchesSuggestionsFunc(separator string
 	return FuzzySearchFunc(self.getRemoteBranchNames(separator))
 }
 
+// GetRefspecSuggestionsFunc suggests refspecs for pushing HEAD to each of the
+// given remote's branches
+func (self *SuggestionsHelper) GetRefspecSuggestionsFunc(remoteName string) func(string) []*types.Suggestion {
+	refspecs := []string{}
+	remote, ok := slices.Find(self.model.Remotes, func(remote *models.Remote) bool {
+		return remote.Name == remoteName
+	})
+	if ok {
+		refspecs = slices.Map(remote.Branches, func(branch *models.RemoteBranch) string {
+			return "HEAD:" + branch.Name
+		})
+	}
+
+	return FuzzySearchFunc(refspecs)
+}
+
 func (self *SuggestionsHelper) getTagNames() []string {
 	return slices.Map(self.model.Tags, func(tag *models.Tag) string {
 		return tag.Name
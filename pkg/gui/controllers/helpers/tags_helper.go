@@ -1,6 +1,8 @@
 package helpers
 
 import (
+	"os"
+
 	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -78,3 +80,32 @@ func (self *TagsHelper) handleCreateLightweightTag(commitSha string, onCreate fu
 		},
 	})
 }
+
+// GetSortOrder returns the sort order last chosen for the current repo's tags,
+// falling back to git.tagSortOrder
+func (self *TagsHelper) GetSortOrder() string {
+	repoPath, err := os.Getwd()
+	if err == nil {
+		if sortOrder := self.c.GetAppState().TagSortOrders[repoPath]; sortOrder != "" {
+			return sortOrder
+		}
+	}
+
+	return self.c.UserConfig.Git.TagSortOrder
+}
+
+// SetSortOrder remembers the sort order for the current repo's tags
+func (self *TagsHelper) SetSortOrder(sortOrder string) error {
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	appState := self.c.GetAppState()
+	if appState.TagSortOrders == nil {
+		appState.TagSortOrders = map[string]string{}
+	}
+	appState.TagSortOrders[repoPath] = sortOrder
+
+	return self.c.SaveAppState()
+}
@@ -65,6 +65,11 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Handler:     self.checkSelected(self.edit),
 			Description: self.c.Tr.LcEditCommit,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.SplitCommit),
+			Handler:     self.checkSelected(self.split),
+			Description: self.c.Tr.LcSplitCommit,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Commits.PickCommit),
 			Handler:     self.checkSelected(self.pick),
@@ -296,6 +301,24 @@ func (self *LocalCommitsController) edit(commit *models.Commit) error {
 	})
 }
 
+func (self *LocalCommitsController) split(commit *models.Commit) error {
+	if commit.IsTODO() {
+		return self.c.ErrorMsg(self.c.Tr.CannotSplitTodoCommit)
+	}
+
+	if commit.IsMerge() {
+		return self.c.ErrorMsg(self.c.Tr.CannotSplitMergeCommit)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.SplitCommitTitle,
+		Prompt: self.c.Tr.SplitCommitPrompt,
+		HandleConfirm: func() error {
+			return self.helpers.SplitCommit.Start(self.model.Commits, self.context().GetSelectedLineIdx())
+		},
+	})
+}
+
 func (self *LocalCommitsController) pick(commit *models.Commit) error {
 	applied, err := self.handleMidRebaseCommand("pick", commit)
 	if err != nil {
@@ -323,6 +346,23 @@ func (self *LocalCommitsController) handleMidRebaseCommand(action string, commit
 		return false, nil
 	}
 
+	if !commit.IsCommitTODO() {
+		if action != "drop" {
+			return true, self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.CannotChangeTodoAction, commit.Action))
+		}
+
+		self.c.LogAction("Update rebase TODO")
+		self.c.LogCommand(fmt.Sprintf("Removing '%s' from rebase TODO", commit.Action), false)
+
+		if err := self.git.Rebase.DeleteRebaseTodo(self.context().GetSelectedLineIdx()); err != nil {
+			return false, self.c.Error(err)
+		}
+
+		return true, self.c.Refresh(types.RefreshOptions{
+			Mode: types.SYNC, Scope: []types.RefreshableView{types.REBASE_COMMITS},
+		})
+	}
+
 	// for now we do not support setting 'reword' because it requires an editor
 	// and that means we either unconditionally wait around for the subprocess to ask for
 	// our input or we set a lazygit client as the EDITOR env variable and have it
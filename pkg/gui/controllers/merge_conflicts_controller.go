@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"fmt"
 	"io/ioutil"
 
 	"github.com/jesseduffield/gocui"
@@ -95,6 +96,12 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Handler:     self.helpers.WorkingTree.OpenMergeTool,
 			Description: self.c.Tr.LcOpenMergeTool,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ResolveConflict),
+			Handler:     self.HandleResolveConflict,
+			Description: self.c.Tr.LcResolveConflict,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Select),
 			Handler:     self.withRenderAndFocus(self.HandlePickHunk),
@@ -105,6 +112,36 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Handler:     self.withRenderAndFocus(self.HandlePickAllHunks),
 			Description: self.c.Tr.PickAllHunks,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ToggleThreeWayMerge),
+			Handler:     self.withRenderAndFocus(self.HandleToggleThreeWay),
+			Description: self.c.Tr.LcToggleThreeWayMerge,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.PickOurs),
+			Handler:     self.withRenderAndFocus(self.pickSide(mergeconflicts.TOP)),
+			Description: self.c.Tr.LcPickOurs,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.PickBase),
+			Handler:     self.withRenderAndFocus(self.pickSide(mergeconflicts.MIDDLE)),
+			Description: self.c.Tr.LcPickBase,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.PickTheirs),
+			Handler:     self.withRenderAndFocus(self.pickSide(mergeconflicts.BOTTOM)),
+			Description: self.c.Tr.LcPickTheirs,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.EditMergeResultLine),
+			Handler:     self.withLock(self.HandleEditLine),
+			Description: self.c.Tr.LcEditMergeResultLine,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.Remove),
+			Handler:     self.withRenderAndFocus(self.HandleDeleteLine),
+			Description: self.c.Tr.LcDeleteMergeResultLine,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Return),
 			Handler:     self.Escape,
@@ -170,6 +207,17 @@ func (self *MergeConflictsController) HandleOpenFile() error {
 	return self.helpers.Files.OpenFileAtLine(self.context().GetState().GetPath(), lineNumber)
 }
 
+func (self *MergeConflictsController) HandleResolveConflict() error {
+	path := self.context().GetState().GetPath()
+	for _, file := range self.model.Files {
+		if file.Name == path {
+			return self.helpers.MergeConflicts.CreateWholeFileConflictMenu(file)
+		}
+	}
+
+	return nil
+}
+
 func (self *MergeConflictsController) HandleScrollLeft() error {
 	self.context().GetViewTrait().ScrollLeft()
 
@@ -201,14 +249,24 @@ func (self *MergeConflictsController) HandleUndo() error {
 
 func (self *MergeConflictsController) PrevConflictHunk() error {
 	self.context().SetUserScrolling(false)
-	self.context().GetState().SelectPrevConflictHunk()
+	state := self.context().GetState()
+	if state.ThreeWay() {
+		state.SelectPrevLine()
+	} else {
+		state.SelectPrevConflictHunk()
+	}
 
 	return nil
 }
 
 func (self *MergeConflictsController) NextConflictHunk() error {
 	self.context().SetUserScrolling(false)
-	self.context().GetState().SelectNextConflictHunk()
+	state := self.context().GetState()
+	if state.ThreeWay() {
+		state.SelectNextLine()
+	} else {
+		state.SelectNextConflictHunk()
+	}
 
 	return nil
 }
@@ -279,8 +337,98 @@ func (self *MergeConflictsController) resolveConflict(selection mergeconflicts.S
 	}
 	self.c.LogAction("Resolve merge conflict")
 	self.c.LogCommand(logStr, false)
+	return true, self.pushContent(content)
+}
+
+func (self *MergeConflictsController) pushContent(content string) error {
+	state := self.context().GetState()
 	state.PushContent(content)
-	return true, ioutil.WriteFile(state.GetPath(), []byte(content), 0o644)
+	return ioutil.WriteFile(state.GetPath(), []byte(content), 0o644)
+}
+
+func (self *MergeConflictsController) HandleToggleThreeWay() error {
+	state := self.context().GetState()
+	state.ToggleThreeWay()
+
+	if state.ThreeWay() {
+		return self.helpers.MergeConflicts.LoadThreeWaySides()
+	}
+
+	return nil
+}
+
+// pickSide resolves the selected conflict with its ours, base or theirs version.
+// The base comes from the file's index stages so this works whatever the
+// conflict style.
+func (self *MergeConflictsController) pickSide(selection mergeconflicts.Selection) func() error {
+	return func() error {
+		self.context().SetUserScrolling(false)
+
+		if err := self.helpers.MergeConflicts.LoadThreeWaySides(); err != nil {
+			return err
+		}
+
+		ok, content := self.context().GetState().ContentAfterPickingSide(selection)
+		if !ok {
+			return nil
+		}
+
+		self.c.LogAction("Resolve merge conflict")
+		self.c.LogCommand(fmt.Sprintf("Picking %s", map[mergeconflicts.Selection]string{
+			mergeconflicts.TOP:    "ours",
+			mergeconflicts.MIDDLE: "base",
+			mergeconflicts.BOTTOM: "theirs",
+		}[selection]), false)
+		if err := self.pushContent(content); err != nil {
+			return err
+		}
+
+		if self.context().GetState().AllConflictsResolved() {
+			return self.onLastConflictResolved()
+		}
+
+		return nil
+	}
+}
+
+func (self *MergeConflictsController) HandleEditLine() error {
+	state := self.context().GetState()
+	if !state.ThreeWay() {
+		return nil
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.EditMergeResultLineTitle,
+		InitialContent: state.SelectedLine(),
+		HandleConfirm: func(line string) error {
+			return self.withRenderAndFocus(func() error {
+				return self.replaceContent(state.ContentAfterEditingLine(line))
+			})()
+		},
+	})
+}
+
+func (self *MergeConflictsController) HandleDeleteLine() error {
+	state := self.context().GetState()
+	if !state.ThreeWay() {
+		return nil
+	}
+
+	return self.replaceContent(state.ContentAfterDeletingLine())
+}
+
+// replaceContent is for when the user has edited the file in the three-way view
+func (self *MergeConflictsController) replaceContent(content string) error {
+	self.c.LogAction("Edit merge result")
+	if err := self.pushContent(content); err != nil {
+		return err
+	}
+
+	if self.context().GetState().AllConflictsResolved() {
+		return self.onLastConflictResolved()
+	}
+
+	return nil
 }
 
 func (self *MergeConflictsController) onLastConflictResolved() error {
@@ -299,7 +447,11 @@ func (self *MergeConflictsController) withRenderAndFocus(f func() error) func()
 			return err
 		}
 
-		return self.context().RenderAndFocus(self.isFocused())
+		if err := self.context().RenderAndFocus(self.isFocused()); err != nil {
+			return err
+		}
+
+		return self.helpers.MergeConflicts.RenderThreeWay()
 	})
 }
 
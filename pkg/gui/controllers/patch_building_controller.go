@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
 )
@@ -44,6 +45,11 @@ func (self *PatchBuildingController) GetKeybindings(opts types.KeybindingsOpts)
 			Handler:     self.Escape,
 			Description: self.c.Tr.ExitCustomPatchBuilder,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.EditSelectHunk),
+			Handler:     self.EditHunkAndRefresh,
+			Description: self.c.Tr.EditHunkAndApplyToIndex,
+		},
 	}
 }
 
@@ -133,6 +139,34 @@ func (self *PatchBuildingController) toggleSelection() error {
 	return nil
 }
 
+// EditHunkAndRefresh lets the user edit the selected hunk of the commit's diff
+// and applies the result to the index, e.g. to bring back part of an old change
+func (self *PatchBuildingController) EditHunkAndRefresh() error {
+	if err := self.editHunk(); err != nil {
+		return err
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+}
+
+func (self *PatchBuildingController) editHunk() error {
+	self.context().GetMutex().Lock()
+	defer self.context().GetMutex().Unlock()
+
+	path := self.contexts.CommitFiles.GetSelectedPath()
+	if path == "" {
+		return nil
+	}
+
+	state := self.context().GetState()
+	hunk := state.CurrentHunk()
+	patchText := patch.ModifiedPatchForRange(
+		self.c.Log, path, state.GetDiff(), hunk.FirstLineIdx, hunk.LastLineIdx(), false, false,
+	)
+
+	return self.helpers.EditHunk.EditAndApply(path, patchText, state.GetSelectedLineIdx()-hunk.FirstLineIdx)
+}
+
 func (self *PatchBuildingController) Escape() error {
 	return self.helpers.PatchBuilding.Escape()
 }
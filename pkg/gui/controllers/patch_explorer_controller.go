@@ -239,17 +239,23 @@ func (self *PatchExplorerController) HandleGotoBottom() error {
 }
 
 func (self *PatchExplorerController) HandleMouseDown() error {
-	self.context.GetState().SelectNewLineForRange(self.context.GetViewTrait().SelectedLineIdx())
+	self.context.GetState().SelectNewLineForRange(self.selectedPatchLineIdx())
 
 	return nil
 }
 
 func (self *PatchExplorerController) HandleMouseDrag() error {
-	self.context.GetState().SelectLine(self.context.GetViewTrait().SelectedLineIdx())
+	self.context.GetState().SelectLine(self.selectedPatchLineIdx())
 
 	return nil
 }
 
+// the line of the patch at the view's cursor, which differs from the line of the
+// view when showing the diff side by side
+func (self *PatchExplorerController) selectedPatchLineIdx() int {
+	return self.context.GetState().PatchLineIdx(self.context.GetViewTrait().SelectedLineIdx())
+}
+
 func (self *PatchExplorerController) CopySelectedToClipboard() error {
 	selected := self.context.GetState().PlainRenderSelected()
 
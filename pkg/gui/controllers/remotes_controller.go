@@ -55,6 +55,21 @@ func (self *RemotesController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Handler:     self.checkSelected(self.edit),
 			Description: self.c.Tr.LcEditRemote,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.FetchAllRemotes),
+			Handler:     self.fetchAll,
+			Description: self.c.Tr.LcFetchAllRemotes,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.EditRemotePushUrl),
+			Handler:     self.checkSelected(self.editPushUrl),
+			Description: self.c.Tr.LcEditRemotePushUrl,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.SetRemoteHead),
+			Handler:     self.checkSelected(self.setHeadAutomatically),
+			Description: self.c.Tr.LcSetRemoteHeadAutomatically,
+		},
 	}
 
 	return bindings
@@ -173,6 +188,56 @@ func (self *RemotesController) fetch(remote *models.Remote) error {
 	})
 }
 
+func (self *RemotesController) fetchAll() error {
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingAllRemotesStatus, func() error {
+		self.c.LogAction(self.c.Tr.Actions.FetchAllRemotes)
+		err := self.git.Sync.FetchAllRemotes()
+		if err != nil {
+			_ = self.c.Error(err)
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES, types.TAGS}})
+	})
+}
+
+func (self *RemotesController) editPushUrl(remote *models.Remote) error {
+	// if no push URL is set, git pushes to the fetch URL
+	url := ""
+	if len(remote.PushUrls) > 0 {
+		url = remote.PushUrls[0]
+	} else if len(remote.Urls) > 0 {
+		url = remote.Urls[0]
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.EditRemotePushUrlTitle,
+			map[string]string{
+				"remoteName": remote.Name,
+			},
+		),
+		InitialContent: url,
+		HandleConfirm: func(updatedUrl string) error {
+			self.c.LogAction(self.c.Tr.Actions.UpdateRemotePushUrl)
+			if err := self.git.Remote.UpdateRemotePushUrl(remote.Name, updatedUrl); err != nil {
+				return self.c.Error(err)
+			}
+			return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.REMOTES}})
+		},
+	})
+}
+
+func (self *RemotesController) setHeadAutomatically(remote *models.Remote) error {
+	return self.c.WithWaitingStatus(self.c.Tr.SettingRemoteHeadStatus, func() error {
+		self.c.LogAction(self.c.Tr.Actions.SetRemoteHead)
+		if err := self.git.Remote.SetRemoteHeadAutomatically(remote.Name); err != nil {
+			_ = self.c.Error(err)
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.REMOTES}})
+	})
+}
+
 func (self *RemotesController) checkSelected(callback func(*models.Remote) error) func() error {
 	return func() error {
 		file := self.context.GetSelected()
@@ -1,8 +1,6 @@
 package controllers
 
 import (
-	"strings"
-
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -208,33 +206,8 @@ func (self *StagingController) editHunk() error {
 	patchText := patch.ModifiedPatchForRange(
 		self.c.Log, path, state.GetDiff(), hunk.FirstLineIdx, hunk.LastLineIdx(), self.staged, false,
 	)
-	patchFilepath, err := self.git.WorkingTree.SaveTemporaryPatch(patchText)
-	if err != nil {
-		return err
-	}
-
-	lineOffset := 3
-	lineIdxInHunk := state.GetSelectedLineIdx() - hunk.FirstLineIdx
-	if err := self.helpers.Files.EditFileAtLine(patchFilepath, lineIdxInHunk+lineOffset); err != nil {
-		return err
-	}
-
-	editedPatchText, err := self.git.File.Cat(patchFilepath)
-	if err != nil {
-		return err
-	}
-
-	self.c.LogAction(self.c.Tr.Actions.ApplyPatch)
-
-	lineCount := strings.Count(editedPatchText, "\n") + 1
-	newPatchText := patch.ModifiedPatchForRange(
-		self.c.Log, path, editedPatchText, 0, lineCount, false, false,
-	)
-	if err := self.git.WorkingTree.ApplyPatch(newPatchText, "cached"); err != nil {
-		return self.c.Error(err)
-	}
 
-	return nil
+	return self.helpers.EditHunk.EditAndApply(path, patchText, state.GetSelectedLineIdx()-hunk.FirstLineIdx)
 }
 
 func (self *StagingController) FilePath() string {
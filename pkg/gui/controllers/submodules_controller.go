@@ -118,7 +118,7 @@ func (self *SubmodulesController) editURL(submodule *models.SubmoduleConfig) err
 		HandleConfirm: func(newUrl string) error {
 			return self.c.WithWaitingStatus(self.c.Tr.LcUpdatingSubmoduleUrlStatus, func() error {
 				self.c.LogAction(self.c.Tr.Actions.UpdateSubmoduleUrl)
-				err := self.git.Submodule.UpdateUrl(submodule.Name, submodule.Path, newUrl)
+				err := self.git.Submodule.UpdateUrl(submodule, newUrl)
 				if err != nil {
 					_ = self.c.Error(err)
 				}
@@ -132,7 +132,7 @@ func (self *SubmodulesController) editURL(submodule *models.SubmoduleConfig) err
 func (self *SubmodulesController) init(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.LcInitializingSubmoduleStatus, func() error {
 		self.c.LogAction(self.c.Tr.Actions.InitialiseSubmodule)
-		err := self.git.Submodule.Init(submodule.Path)
+		err := self.git.Submodule.Init(submodule)
 		if err != nil {
 			_ = self.c.Error(err)
 		}
@@ -174,6 +174,34 @@ func (self *SubmodulesController) openBulkActionsMenu() error {
 				},
 				Key: 'u',
 			},
+			{
+				LabelColumns: []string{self.c.Tr.LcBulkUpdateSubmodulesRecursively, style.FgYellow.Sprint(self.git.Submodule.BulkUpdateRecursivelyCmdObj().ToString())},
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.LcRunningCommand, func() error {
+						self.c.LogAction(self.c.Tr.Actions.BulkUpdateSubmodulesRecursively)
+						if err := self.git.Submodule.BulkUpdateRecursivelyCmdObj().Run(); err != nil {
+							return self.c.Error(err)
+						}
+
+						return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES}})
+					})
+				},
+				Key: 'r',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcBulkSyncSubmodulesRecursively, style.FgYellow.Sprint(self.git.Submodule.BulkSyncRecursivelyCmdObj().ToString())},
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.LcRunningCommand, func() error {
+						self.c.LogAction(self.c.Tr.Actions.BulkSyncSubmodulesRecursively)
+						if err := self.git.Submodule.BulkSyncRecursivelyCmdObj().Run(); err != nil {
+							return self.c.Error(err)
+						}
+
+						return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES}})
+					})
+				},
+				Key: 's',
+			},
 			{
 				LabelColumns: []string{self.c.Tr.LcBulkDeinitSubmodules, style.FgRed.Sprint(self.git.Submodule.BulkDeinitCmdObj().ToString())},
 				OnPress: func() error {
@@ -195,7 +223,7 @@ func (self *SubmodulesController) openBulkActionsMenu() error {
 func (self *SubmodulesController) update(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.LcUpdatingSubmoduleStatus, func() error {
 		self.c.LogAction(self.c.Tr.Actions.UpdateSubmodule)
-		err := self.git.Submodule.Update(submodule.Path)
+		err := self.git.Submodule.Update(submodule)
 		if err != nil {
 			_ = self.c.Error(err)
 		}
@@ -2,11 +2,15 @@ package controllers
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type SyncController struct {
@@ -37,6 +41,18 @@ func (self *SyncController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Handler:     opts.Guards.NoPopupPanel(self.HandlePull),
 			Description: self.c.Tr.LcPull,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ViewPushOptions),
+			Handler:     opts.Guards.NoPopupPanel(self.HandlePushMenu),
+			Description: self.c.Tr.LcViewPushOptions,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ViewPullOptions),
+			Handler:     opts.Guards.NoPopupPanel(self.HandlePullMenu),
+			Description: self.c.Tr.LcViewPullOptions,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -50,10 +66,18 @@ func (self *SyncController) HandlePush() error {
 	return self.branchCheckedOut(self.push)()
 }
 
+func (self *SyncController) HandlePushMenu() error {
+	return self.branchCheckedOut(self.createPushMenu)()
+}
+
 func (self *SyncController) HandlePull() error {
 	return self.branchCheckedOut(self.pull)()
 }
 
+func (self *SyncController) HandlePullMenu() error {
+	return self.branchCheckedOut(self.createPullMenu)()
+}
+
 func (self *SyncController) branchCheckedOut(f func(*models.Branch) error) func() error {
 	return func() error {
 		currentBranch := self.helpers.Refs.GetCheckedOutRef()
@@ -67,17 +91,22 @@ func (self *SyncController) branchCheckedOut(f func(*models.Branch) error) func(
 }
 
 func (self *SyncController) push(currentBranch *models.Branch) error {
+	return self.pushWithOpts(currentBranch, pushOpts{})
+}
+
+// pushWithOpts pushes the current branch, setting its upstream first if need be
+func (self *SyncController) pushWithOpts(currentBranch *models.Branch, opts pushOpts) error {
 	// if we have pullables we'll ask if the user wants to force push
 	if currentBranch.IsTrackingRemote() {
-		opts := pushOpts{}
 		if currentBranch.HasCommitsToPull() {
 			return self.requestToForcePush(opts)
 		} else {
 			return self.pushAux(opts)
 		}
 	} else {
+		opts.setUpstream = true
 		if self.git.Config.GetPushToCurrent() {
-			return self.pushAux(pushOpts{setUpstream: true})
+			return self.pushAux(opts)
 		} else {
 			return self.helpers.Upstream.PromptForUpstreamWithInitialContent(currentBranch, func(upstream string) error {
 				upstreamRemote, upstreamBranch, err := self.helpers.Upstream.ParseUpstream(upstream)
@@ -85,18 +114,154 @@ func (self *SyncController) push(currentBranch *models.Branch) error {
 					return self.c.Error(err)
 				}
 
-				return self.pushAux(pushOpts{
-					setUpstream:    true,
-					upstreamRemote: upstreamRemote,
-					upstreamBranch: upstreamBranch,
-				})
+				opts.upstreamRemote = upstreamRemote
+				opts.upstreamBranch = upstreamBranch
+				return self.pushAux(opts)
 			})
 		}
 	}
 }
 
+func (self *SyncController) createPushMenu(currentBranch *models.Branch) error {
+	// We record the upstream's sha now rather than when the item is pressed so
+	// that the lease is what the user saw when deciding to push
+	upstreamSha := ""
+	if currentBranch.IsTrackingRemote() {
+		upstreamSha, _ = self.git.Sync.RemoteBranchSha(currentBranch.UpstreamRemote, currentBranch.UpstreamBranch)
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PushOptionsTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.LcPushToRefspec,
+				Key:     'r',
+				OnPress: func() error { return self.pushToRefspec(currentBranch) },
+			},
+			{
+				Label:   self.c.Tr.LcAtomicPush,
+				Key:     'a',
+				OnPress: func() error { return self.pushWithOpts(currentBranch, pushOpts{atomic: true}) },
+			},
+			{
+				Label:   self.c.Tr.LcPushWithPushOptions,
+				Key:     'o',
+				OnPress: func() error { return self.pushWithPushOptions(currentBranch) },
+			},
+			{
+				Label:   self.c.Tr.LcPushWithoutHooks,
+				Key:     'n',
+				OnPress: func() error { return self.pushWithOpts(currentBranch, pushOpts{noVerify: true}) },
+			},
+			{
+				Label:   self.c.Tr.LcPushTagsOnly,
+				Key:     't',
+				OnPress: func() error { return self.pushTagsOnly(currentBranch) },
+			},
+			{
+				Label:   self.c.Tr.LcForcePushWithLeaseOnLastFetch,
+				Key:     'f',
+				OnPress: func() error { return self.forcePushWithLeaseOnLastFetch(currentBranch, upstreamSha) },
+			},
+		},
+	})
+}
+
+func (self *SyncController) promptForRemote(currentBranch *models.Branch, handleConfirm func(remoteName string) error) error {
+	initialContent := currentBranch.UpstreamRemote
+	if initialContent == "" {
+		initialContent = "origin"
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.PushRemotePromptTitle,
+		InitialContent:      initialContent,
+		FindSuggestionsFunc: self.helpers.Suggestions.GetRemoteSuggestionsFunc(),
+		HandleConfirm:       handleConfirm,
+	})
+}
+
+func (self *SyncController) pushToRefspec(currentBranch *models.Branch) error {
+	return self.promptForRemote(currentBranch, func(remoteName string) error {
+		return self.c.Prompt(types.PromptOpts{
+			Title:               self.c.Tr.PushRefspecPromptTitle,
+			InitialContent:      "HEAD:",
+			FindSuggestionsFunc: self.helpers.Suggestions.GetRefspecSuggestionsFunc(remoteName),
+			HandleConfirm: func(refspec string) error {
+				return self.pushAux(pushOpts{upstreamRemote: remoteName, refspec: refspec})
+			},
+		})
+	})
+}
+
+func (self *SyncController) pushWithPushOptions(currentBranch *models.Branch) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.PushOptionsPromptTitle,
+		HandleConfirm: func(response string) error {
+			pushOptions := slices.FilterMap(strings.Split(response, ","), func(pushOption string) (string, bool) {
+				pushOption = strings.TrimSpace(pushOption)
+				return pushOption, pushOption != ""
+			})
+
+			return self.pushWithOpts(currentBranch, pushOpts{pushOptions: pushOptions})
+		},
+	})
+}
+
+func (self *SyncController) pushTagsOnly(currentBranch *models.Branch) error {
+	return self.promptForRemote(currentBranch, func(remoteName string) error {
+		return self.pushAux(pushOpts{upstreamRemote: remoteName, tagsOnly: true})
+	})
+}
+
+// forcePushWithLeaseOnLastFetch force pushes, but only if the upstream branch
+// still points at the given sha, which is what our remote-tracking branch
+// pointed to when the push menu was opened. Note that a fetch done before then
+// (including a background fetch) will have moved the remote-tracking branch, so
+// this only protects against changes to the remote made since the menu opened.
+func (self *SyncController) forcePushWithLeaseOnLastFetch(currentBranch *models.Branch, sha string) error {
+	if self.c.UserConfig.Git.DisableForcePushing {
+		return self.c.ErrorMsg(self.c.Tr.ForcePushDisabled)
+	}
+
+	if !currentBranch.IsTrackingRemote() || sha == "" {
+		return self.c.ErrorMsg(self.c.Tr.ForcePushWithLeaseNoUpstream)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.ForcePush,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.ForcePushWithLeasePrompt,
+			map[string]string{
+				"upstream": currentBranch.UpstreamRemote + "/" + currentBranch.UpstreamBranch,
+				"sha":      utils.ShortSha(sha),
+			},
+		),
+		HandleConfirm: func() error {
+			return self.pushAux(pushOpts{
+				force:             true,
+				forceWithLeaseRef: currentBranch.UpstreamBranch,
+				forceWithLeaseSha: sha,
+			})
+		},
+	})
+}
+
 func (self *SyncController) pull(currentBranch *models.Branch) error {
-	action := self.c.Tr.Actions.Pull
+	// unless a strategy has been picked for this branch from the pull options
+	// menu, we leave it to git's own config
+	preference, _ := self.getPullPreference(currentBranch)
+	return self.pullWithPreference(currentBranch, preference)
+}
+
+func (self *SyncController) pullWithPreference(currentBranch *models.Branch, preference config.PullPreference) error {
+	opts := PullFilesOptions{
+		Action:          self.c.Tr.Actions.Pull,
+		Rebase:          preference.Strategy == PULL_STRATEGY_REBASE,
+		NoRebase:        preference.Strategy == PULL_STRATEGY_MERGE,
+		FastForwardOnly: preference.Strategy == PULL_STRATEGY_FF_ONLY,
+		AutoStash:       preference.AutoStash,
+	}
 
 	// if we have no upstream branch we need to set that first
 	if !currentBranch.IsTrackingRemote() {
@@ -105,11 +270,97 @@ func (self *SyncController) pull(currentBranch *models.Branch) error {
 				return self.c.Error(err)
 			}
 
-			return self.PullAux(PullFilesOptions{Action: action})
+			return self.PullAux(opts)
 		})
 	}
 
-	return self.PullAux(PullFilesOptions{Action: action})
+	return self.PullAux(opts)
+}
+
+const (
+	PULL_STRATEGY_REBASE  = "rebase"
+	PULL_STRATEGY_MERGE   = "merge"
+	PULL_STRATEGY_FF_ONLY = "ff-only"
+)
+
+func (self *SyncController) createPullMenu(currentBranch *models.Branch) error {
+	preference, _ := self.getPullPreference(currentBranch)
+	return self.pullMenu(currentBranch, preference.AutoStash)
+}
+
+// pullMenu shows the pull strategies. Toggling autostash shows the menu again with
+// the new value, so that it can be combined with any of the strategies
+func (self *SyncController) pullMenu(currentBranch *models.Branch, autoStash bool) error {
+	preference, _ := self.getPullPreference(currentBranch)
+	lastUsed := preference.Strategy
+
+	strategyItem := func(label string, key types.Key, strategy string) *types.MenuItem {
+		if strategy == lastUsed {
+			label += " " + self.c.Tr.LastUsedSuffix
+		}
+
+		return &types.MenuItem{
+			Label: label,
+			Key:   key,
+			OnPress: func() error {
+				preference := config.PullPreference{Strategy: strategy, AutoStash: autoStash}
+				if err := self.setPullPreference(currentBranch, preference); err != nil {
+					return self.c.Error(err)
+				}
+
+				return self.pullWithPreference(currentBranch, preference)
+			},
+		}
+	}
+
+	autoStashCheckbox := "[ ]"
+	if autoStash {
+		autoStashCheckbox = "[x]"
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PullOptionsTitle,
+		Items: []*types.MenuItem{
+			strategyItem(self.c.Tr.LcPullWithRebase, 'r', PULL_STRATEGY_REBASE),
+			strategyItem(self.c.Tr.LcPullWithMerge, 'm', PULL_STRATEGY_MERGE),
+			strategyItem(self.c.Tr.LcPullFastForwardOnly, 'f', PULL_STRATEGY_FF_ONLY),
+			{
+				Label:   fmt.Sprintf("%s %s", autoStashCheckbox, self.c.Tr.LcPullAutoStash),
+				Key:     'a',
+				OnPress: func() error { return self.pullMenu(currentBranch, !autoStash) },
+			},
+		},
+	})
+}
+
+// getPullPreference returns the pull preference chosen for the branch from the
+// pull options menu, and false if none has been chosen
+func (self *SyncController) getPullPreference(branch *models.Branch) (config.PullPreference, bool) {
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return config.PullPreference{}, false
+	}
+
+	preference, ok := self.c.GetAppState().PullPreferences[repoPath][branch.Name]
+	return preference, ok
+}
+
+func (self *SyncController) setPullPreference(branch *models.Branch, preference config.PullPreference) error {
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	appState := self.c.GetAppState()
+	if appState.PullPreferences == nil {
+		appState.PullPreferences = map[string]map[string]config.PullPreference{}
+	}
+	if appState.PullPreferences[repoPath] == nil {
+		appState.PullPreferences[repoPath] = map[string]config.PullPreference{}
+	}
+	appState.PullPreferences[repoPath][branch.Name] = preference
+
+	return self.c.SaveAppState()
 }
 
 func (self *SyncController) setCurrentBranchUpstream(upstream string) error {
@@ -134,6 +385,9 @@ type PullFilesOptions struct {
 	UpstreamRemote  string
 	UpstreamBranch  string
 	FastForwardOnly bool
+	Rebase          bool
+	NoRebase        bool
+	AutoStash       bool
 	Action          string
 }
 
@@ -151,27 +405,73 @@ func (self *SyncController) pullWithLock(opts PullFilesOptions) error {
 			RemoteName:      opts.UpstreamRemote,
 			BranchName:      opts.UpstreamBranch,
 			FastForwardOnly: opts.FastForwardOnly,
+			Rebase:          opts.Rebase,
+			NoRebase:        opts.NoRebase,
+			AutoStash:       opts.AutoStash,
 		},
 	)
 
+	if opts.Rebase {
+		return self.helpers.MergeAndRebase.CheckMergeOrRebaseWithConflictHandler(err, self.switchToFirstConflict)
+	}
+
 	return self.helpers.MergeAndRebase.CheckMergeOrRebase(err)
 }
 
+// switchToFirstConflict takes the user straight to the merge conflicts panel for
+// the first conflicted file, falling back to the files panel
+func (self *SyncController) switchToFirstConflict() error {
+	if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}}); err != nil {
+		return err
+	}
+
+	if err := self.c.PushContext(self.contexts.Files); err != nil {
+		return err
+	}
+
+	file, ok := slices.Find(self.model.Files, func(file *models.File) bool {
+		return file.HasInlineMergeConflicts
+	})
+	if !ok {
+		return nil
+	}
+
+	if index, ok := self.contexts.Files.GetIndexForPath(file.Name); ok {
+		self.contexts.Files.SetSelectedLineIdx(index)
+	}
+
+	return self.helpers.MergeConflicts.SwitchToMerge(file.Name)
+}
+
 type pushOpts struct {
-	force          bool
-	upstreamRemote string
-	upstreamBranch string
-	setUpstream    bool
+	force             bool
+	upstreamRemote    string
+	upstreamBranch    string
+	setUpstream       bool
+	refspec           string
+	forceWithLeaseRef string
+	forceWithLeaseSha string
+	atomic            bool
+	noVerify          bool
+	tagsOnly          bool
+	pushOptions       []string
 }
 
 func (self *SyncController) pushAux(opts pushOpts) error {
 	return self.c.WithLoaderPanel(self.c.Tr.PushWait, func() error {
 		self.c.LogAction(self.c.Tr.Actions.Push)
 		err := self.git.Sync.Push(git_commands.PushOpts{
-			Force:          opts.force,
-			UpstreamRemote: opts.upstreamRemote,
-			UpstreamBranch: opts.upstreamBranch,
-			SetUpstream:    opts.setUpstream,
+			Force:             opts.force,
+			UpstreamRemote:    opts.upstreamRemote,
+			UpstreamBranch:    opts.upstreamBranch,
+			SetUpstream:       opts.setUpstream,
+			Refspec:           opts.refspec,
+			ForceWithLeaseRef: opts.forceWithLeaseRef,
+			ForceWithLeaseSha: opts.forceWithLeaseSha,
+			Atomic:            opts.atomic,
+			NoVerify:          opts.noVerify,
+			TagsOnly:          opts.tagsOnly,
+			PushOptions:       opts.pushOptions,
 		})
 		if err != nil {
 			if !opts.force && strings.Contains(err.Error(), "Updates were rejected") {
@@ -35,6 +35,11 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Handler:     self.withSelectedTag(self.delete),
 			Description: self.c.Tr.LcDeleteTag,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.DeleteRemoteTag),
+			Handler:     self.withSelectedTag(self.deleteRemote),
+			Description: self.c.Tr.LcDeleteRemoteTag,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Branches.PushTag),
 			Handler:     self.withSelectedTag(self.push),
@@ -51,6 +56,12 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Description: self.c.Tr.LcViewResetOptions,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.TagSortOrder),
+			Handler:     self.createSortMenu,
+			Description: self.c.Tr.LcSortOrder,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -85,6 +96,44 @@ func (self *TagsController) delete(tag *models.Tag) error {
 	})
 }
 
+func (self *TagsController) deleteRemote(tag *models.Tag) error {
+	title := utils.ResolvePlaceholderString(
+		self.c.Tr.DeleteRemoteTagTitle,
+		map[string]string{
+			"tagName": tag.Name,
+		},
+	)
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               title,
+		InitialContent:      "origin",
+		FindSuggestionsFunc: self.helpers.Suggestions.GetRemoteSuggestionsFunc(),
+		HandleConfirm: func(remoteName string) error {
+			prompt := utils.ResolvePlaceholderString(
+				self.c.Tr.DeleteRemoteTagPrompt,
+				map[string]string{
+					"tagName":    tag.Name,
+					"remoteName": remoteName,
+				},
+			)
+
+			return self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.DeleteTagTitle,
+				Prompt: prompt,
+				HandleConfirm: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func() error {
+						self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
+						if err := self.git.Tag.DeleteRemote(remoteName, tag.Name); err != nil {
+							return self.c.Error(err)
+						}
+						return nil
+					})
+				},
+			})
+		},
+	})
+}
+
 func (self *TagsController) push(tag *models.Tag) error {
 	title := utils.ResolvePlaceholderString(
 		self.c.Tr.PushTagTitle,
@@ -120,6 +169,36 @@ func (self *TagsController) create() error {
 	return self.helpers.Tags.CreateTagMenu("", func() { self.context().SetSelectedLineIdx(0) })
 }
 
+func (self *TagsController) createSortMenu() error {
+	onPress := func(value string) func() error {
+		return func() error {
+			if err := self.helpers.Tags.SetSortOrder(value); err != nil {
+				return self.c.Error(err)
+			}
+			self.context().SetSelectedLineIdx(0)
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.TAGS}})
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.TagSortOrderTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   "date",
+				OnPress: onPress("date"),
+			},
+			{
+				Label:   "semver",
+				OnPress: onPress("semver"),
+			},
+			{
+				Label:   "alphabetical",
+				OnPress: onPress("alphabetical"),
+			},
+		},
+	})
+}
+
 func (self *TagsController) withSelectedTag(f func(tag *models.Tag) error) func() error {
 	return func() error {
 		tag := self.context().GetSelected()
@@ -174,6 +174,7 @@ func (self *UndoController) reflogRedo() error {
 // Here we're going through the reflog and maintaining a counter that represents how many
 // undos/redos/user actions we've seen. when we hit a user action we call the callback specifying
 // what the counter is up to and the nature of the action.
+// Newer versions of git omit the '-i' from interactive rebase reflog entries so we match either form.
 // If we find ourselves mid-rebase, we just return because undo/redo mid rebase
 // requires knowledge of previous TODO file states, which you can't just get from the reflog.
 // Though we might support this later, hence the use of the CURRENT_REBASE action kind.
@@ -195,17 +196,17 @@ func (self *UndoController) parseReflogForActions(onUserAction func(counter int,
 				counter++
 			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^\[lazygit redo\]`); ok {
 				counter--
-			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase -i \(abort\)|^rebase -i \(finish\)`); ok {
+			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase (-i )?\(abort\)|^rebase (-i )?\(finish\)`); ok {
 				rebaseFinishCommitSha = reflogCommit.Sha
 			} else if ok, match := utils.FindStringSubmatch(reflogCommit.Name, `^checkout: moving from ([\S]+) to ([\S]+)`); ok {
 				action = &reflogAction{kind: CHECKOUT, from: match[1], to: match[2]}
 			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^commit|^reset: moving to|^pull`); ok {
 				action = &reflogAction{kind: COMMIT, from: prevCommitSha, to: reflogCommit.Sha}
-			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase -i \(start\)`); ok {
+			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase (-i )?\(start\)`); ok {
 				// if we're here then we must be currently inside an interactive rebase
 				action = &reflogAction{kind: CURRENT_REBASE, from: prevCommitSha}
 			}
-		} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase -i \(start\)`); ok {
+		} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase (-i )?\(start\)`); ok {
 			action = &reflogAction{kind: REBASE, from: prevCommitSha, to: rebaseFinishCommitSha}
 			rebaseFinishCommitSha = ""
 		}
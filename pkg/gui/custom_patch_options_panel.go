@@ -5,10 +5,21 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 func (gui *Gui) handleCreatePatchOptionsMenu() error {
+	importMenuItem := &types.MenuItem{
+		Label:   gui.c.Tr.LcImportPatchFromFile,
+		OnPress: gui.handleImportPatch,
+		Key:     'I',
+	}
+
 	if !gui.git.Patch.PatchManager.Active() {
+		// we can start a patch from a file as long as we know which commit it's against
+		if gui.currentContext().GetKey() == gui.State.Contexts.CommitFiles.GetKey() {
+			return gui.c.Menu(types.CreateMenuOptions{Title: gui.c.Tr.PatchOptionsTitle, Items: []*types.MenuItem{importMenuItem}})
+		}
 		return gui.c.ErrorMsg(gui.c.Tr.NoPatchError)
 	}
 
@@ -69,6 +80,13 @@ func (gui *Gui) handleCreatePatchOptionsMenu() error {
 		}
 	}
 
+	// these come last so that they don't shift the positions of the other items
+	menuItems = append(menuItems, &types.MenuItem{
+		Label:   gui.c.Tr.LcExportPatchToFile,
+		OnPress: gui.handleExportPatch,
+		Key:     'e',
+	}, importMenuItem)
+
 	return gui.c.Menu(types.CreateMenuOptions{Title: gui.c.Tr.PatchOptionsTitle, Items: menuItems})
 }
 
@@ -123,8 +141,18 @@ func (gui *Gui) handleMovePatchToSelectedCommit() error {
 
 	return gui.c.WithWaitingStatus(gui.c.Tr.RebasingStatus, func() error {
 		commitIndex := gui.getPatchCommitIndex()
+		baseCommitIndex, err := gui.git.Patch.GetPatchBaseCommitIdx(gui.State.Model.Commits, commitIndex)
+		if err != nil {
+			return gui.c.Error(err)
+		}
+
 		gui.c.LogAction(gui.c.Tr.Actions.MovePatchToSelectedCommit)
-		err := gui.git.Patch.MovePatchToSelectedCommit(gui.State.Model.Commits, commitIndex, gui.State.Contexts.LocalCommits.GetSelectedLineIdx())
+		err = gui.git.Patch.MovePatchToSelectedCommit(
+			gui.State.Model.Commits,
+			commitIndex,
+			baseCommitIndex,
+			gui.State.Contexts.LocalCommits.GetSelectedLineIdx(),
+		)
 		return gui.helpers.MergeAndRebase.CheckMergeOrRebase(err)
 	})
 }
@@ -192,3 +220,58 @@ func (gui *Gui) handleApplyPatch(reverse bool) error {
 	}
 	return gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
 }
+
+func (gui *Gui) handleExportPatch() error {
+	return gui.c.Prompt(types.PromptOpts{
+		Title:               gui.c.Tr.ExportPatchTitle,
+		InitialContent:      utils.ShortSha(gui.git.Patch.PatchManager.To) + ".patch",
+		FindSuggestionsFunc: gui.helpers.Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			gui.c.LogAction(gui.c.Tr.Actions.ExportPatch)
+			if err := gui.git.Patch.ExportCustomPatch(path); err != nil {
+				return gui.c.Error(err)
+			}
+			gui.c.Toast(fmt.Sprintf(gui.c.Tr.PatchExported, path))
+			return nil
+		},
+	})
+}
+
+func (gui *Gui) handleImportPatch() error {
+	return gui.c.Prompt(types.PromptOpts{
+		Title:               gui.c.Tr.ImportPatchTitle,
+		FindSuggestionsFunc: gui.helpers.Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			patchManager := gui.git.Patch.PatchManager
+			if !patchManager.Active() {
+				commitFilesContext := gui.State.Contexts.CommitFiles
+				ref := commitFilesContext.GetRef()
+				from, reverse := gui.State.Modes.Diffing.GetFromAndReverseArgsForDiff(ref.ParentRefName())
+				patchManager.Start(from, ref.RefName(), reverse, commitFilesContext.GetCanRebase())
+			}
+
+			gui.c.LogAction(gui.c.Tr.Actions.ImportPatch)
+			if err := gui.git.Patch.ImportCustomPatch(path); err != nil {
+				if patchManager.IsEmpty() {
+					patchManager.Reset()
+				}
+				return gui.c.Error(err)
+			}
+
+			if err := gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.COMMIT_FILES}}); err != nil {
+				return err
+			}
+
+			// refreshing the current context so that the patch is shown in the secondary panel
+			return gui.c.PostRefreshUpdate(gui.c.CurrentContext())
+		},
+	})
+}
+
+// saveCustomPatch persists the custom patch so that we can pick up where we left off
+// next time we open this repo
+func (gui *Gui) saveCustomPatch() {
+	if err := gui.git.Patch.SaveCustomPatch(); err != nil {
+		gui.c.Log.Error(err)
+	}
+}
@@ -45,6 +45,17 @@ func (gui *Gui) filesRenderToMain() error {
 
 	gui.helpers.MergeConflicts.ResetMergeState()
 
+	// we show the diff of a file that rerere resolved so that the user can review it
+	if node.File != nil && node.File.HasMergeConflicts && !node.File.ResolvedByRerere {
+		return gui.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: gui.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: gui.c.Tr.MergeConflictsTitle,
+				Task:  types.NewRenderStringTask(gui.helpers.MergeConflicts.ConflictExplanation(node.File)),
+			},
+		})
+	}
+
 	pair := gui.c.MainViewPairs().Normal
 	if node.File != nil {
 		pair = gui.c.MainViewPairs().Staging
@@ -23,3 +23,16 @@ func (self *CommitFileNode) Raw() *Node[models.CommitFile] {
 
 	return self.Node
 }
+
+// GetDiffStat returns the number of lines added and deleted in the file, or in
+// all the files within the directory
+func (self *CommitFileNode) GetDiffStat() (int, int) {
+	linesAdded, linesDeleted := 0, 0
+	_ = self.ForEachFile(func(file *models.CommitFile) error {
+		linesAdded += file.LinesAdded
+		linesDeleted += file.LinesDeleted
+		return nil
+	})
+
+	return linesAdded, linesDeleted
+}
@@ -14,6 +14,8 @@ type ICommitFileTree interface {
 	GetAllItems() []*CommitFileNode
 	GetAllFiles() []*models.CommitFile
 	GetRoot() *CommitFileNode
+	SetFileFilter(filter FileFilter)
+	GetFileFilter() FileFilter
 }
 
 type CommitFileTree struct {
@@ -21,6 +23,7 @@ type CommitFileTree struct {
 	tree           *Node[models.CommitFile]
 	showTree       bool
 	log            *logrus.Entry
+	fileFilter     FileFilter
 	collapsedPaths *CollapsedPaths
 }
 
@@ -74,13 +77,23 @@ func (self *CommitFileTree) GetAllFiles() []*models.CommitFile {
 }
 
 func (self *CommitFileTree) SetTree() {
+	filesForDisplay := filterFiles(self.fileFilter, self.getFiles(), func(file *models.CommitFile) string { return file.Name }, commitFileChangeTypes)
 	if self.showTree {
-		self.tree = BuildTreeFromCommitFiles(self.getFiles())
+		self.tree = BuildTreeFromCommitFiles(filesForDisplay)
 	} else {
-		self.tree = BuildFlatTreeFromCommitFiles(self.getFiles())
+		self.tree = BuildFlatTreeFromCommitFiles(filesForDisplay)
 	}
 }
 
+func (self *CommitFileTree) SetFileFilter(filter FileFilter) {
+	self.fileFilter = filter
+	self.SetTree()
+}
+
+func (self *CommitFileTree) GetFileFilter() FileFilter {
+	return self.fileFilter
+}
+
 func (self *CommitFileTree) IsCollapsed(path string) bool {
 	return self.collapsedPaths.IsCollapsed(path)
 }
@@ -87,6 +87,11 @@ func (self *CommitFileTreeViewModel) GetSelectedPath() string {
 	return node.GetPath()
 }
 
+func (self *CommitFileTreeViewModel) SetFileFilter(filter FileFilter) {
+	self.ICommitFileTree.SetFileFilter(filter)
+	self.IListCursor.SetSelectedLineIdx(0)
+}
+
 // duplicated from file_tree_view_model.go. Generics will help here
 func (self *CommitFileTreeViewModel) ToggleShowTree() {
 	selectedNode := self.GetSelected()
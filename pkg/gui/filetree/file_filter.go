@@ -0,0 +1,124 @@
+package filetree
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// A FileFilter narrows down the files shown in a file tree by their path and/or
+// the kind of change made to them. It's independent of the FileTreeDisplayFilter
+// of the files panel, and both apply.
+
+type ChangeType string
+
+const (
+	CHANGE_TYPE_ADDED      ChangeType = "added"
+	CHANGE_TYPE_MODIFIED   ChangeType = "modified"
+	CHANGE_TYPE_DELETED    ChangeType = "deleted"
+	CHANGE_TYPE_RENAMED    ChangeType = "renamed"
+	CHANGE_TYPE_UNTRACKED  ChangeType = "untracked"
+	CHANGE_TYPE_CONFLICTED ChangeType = "conflicted"
+)
+
+var AllChangeTypes = []ChangeType{
+	CHANGE_TYPE_ADDED,
+	CHANGE_TYPE_MODIFIED,
+	CHANGE_TYPE_DELETED,
+	CHANGE_TYPE_RENAMED,
+	CHANGE_TYPE_UNTRACKED,
+	CHANGE_TYPE_CONFLICTED,
+}
+
+type FileFilter struct {
+	// a glob like '*.go', matched against both the path and the file name, or if
+	// it has no wildcards, text to fuzzy match against the path
+	Text string
+	// we show files with any of these kinds of change, or all files if empty
+	ChangeTypes []ChangeType
+}
+
+func (self FileFilter) IsEmpty() bool {
+	return self.Text == "" && len(self.ChangeTypes) == 0
+}
+
+func (self FileFilter) isGlob() bool {
+	return strings.ContainsAny(self.Text, "*?[")
+}
+
+func (self FileFilter) matchesGlob(path string) bool {
+	if matched, _ := filepath.Match(self.Text, path); matched {
+		return true
+	}
+
+	matched, _ := filepath.Match(self.Text, filepath.Base(path))
+	return matched
+}
+
+// filterFiles returns the files that pass the filter, in their original order
+func filterFiles[T any](filter FileFilter, files []*T, getPath func(*T) string, getChangeTypes func(*T) []ChangeType) []*T {
+	if filter.IsEmpty() {
+		return files
+	}
+
+	if len(filter.ChangeTypes) > 0 {
+		files = slices.Filter(files, func(file *T) bool {
+			return lo.Some(getChangeTypes(file), filter.ChangeTypes)
+		})
+	}
+
+	switch {
+	case filter.Text == "":
+		return files
+	case filter.isGlob():
+		return slices.Filter(files, func(file *T) bool {
+			return filter.matchesGlob(getPath(file))
+		})
+	default:
+		matchingPaths := set.NewFromSlice(utils.FuzzySearch(filter.Text, slices.Map(files, getPath)))
+		return slices.Filter(files, func(file *T) bool {
+			return matchingPaths.Includes(getPath(file))
+		})
+	}
+}
+
+func fileChangeTypes(file *models.File) []ChangeType {
+	result := []ChangeType{}
+	if file.HasMergeConflicts {
+		result = append(result, CHANGE_TYPE_CONFLICTED)
+	}
+	if !file.Tracked {
+		result = append(result, CHANGE_TYPE_UNTRACKED)
+	} else if file.Added {
+		result = append(result, CHANGE_TYPE_ADDED)
+	}
+	if file.Deleted {
+		result = append(result, CHANGE_TYPE_DELETED)
+	}
+	if file.IsRename() {
+		result = append(result, CHANGE_TYPE_RENAMED)
+	}
+	if strings.Contains(file.ShortStatus, "M") {
+		result = append(result, CHANGE_TYPE_MODIFIED)
+	}
+
+	return result
+}
+
+func commitFileChangeTypes(file *models.CommitFile) []ChangeType {
+	switch {
+	case strings.HasPrefix(file.ChangeStatus, "A"):
+		return []ChangeType{CHANGE_TYPE_ADDED}
+	case strings.HasPrefix(file.ChangeStatus, "D"):
+		return []ChangeType{CHANGE_TYPE_DELETED}
+	case strings.HasPrefix(file.ChangeStatus, "R"):
+		return []ChangeType{CHANGE_TYPE_RENAMED}
+	default:
+		return []ChangeType{CHANGE_TYPE_MODIFIED}
+	}
+}
@@ -49,3 +49,16 @@ func (self *FileNode) GetPreviousPath() string {
 
 	return self.File.PreviousName
 }
+
+// GetDiffStat returns the number of lines added and deleted in the file, or in
+// all the files within the directory
+func (self *FileNode) GetDiffStat() (int, int) {
+	linesAdded, linesDeleted := 0, 0
+	_ = self.ForEachFile(func(file *models.File) error {
+		linesAdded += file.LinesAdded
+		linesDeleted += file.LinesDeleted
+		return nil
+	})
+
+	return linesAdded, linesDeleted
+}
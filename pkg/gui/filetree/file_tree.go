@@ -35,11 +35,13 @@ type IFileTree interface {
 
 	FilterFiles(test func(*models.File) bool) []*models.File
 	SetFilter(filter FileTreeDisplayFilter)
+	SetFileFilter(filter FileFilter)
 	Get(index int) *FileNode
 	GetFile(path string) *models.File
 	GetAllItems() []*FileNode
 	GetAllFiles() []*models.File
 	GetFilter() FileTreeDisplayFilter
+	GetFileFilter() FileFilter
 	GetRoot() *FileNode
 }
 
@@ -49,6 +51,7 @@ type FileTree struct {
 	showTree       bool
 	log            *logrus.Entry
 	filter         FileTreeDisplayFilter
+	fileFilter     FileFilter
 	collapsedPaths *CollapsedPaths
 }
 
@@ -73,18 +76,21 @@ func (self *FileTree) ExpandToPath(path string) {
 }
 
 func (self *FileTree) getFilesForDisplay() []*models.File {
+	var files []*models.File
 	switch self.filter {
 	case DisplayAll:
-		return self.getFiles()
+		files = self.getFiles()
 	case DisplayStaged:
-		return self.FilterFiles(func(file *models.File) bool { return file.HasStagedChanges })
+		files = self.FilterFiles(func(file *models.File) bool { return file.HasStagedChanges })
 	case DisplayUnstaged:
-		return self.FilterFiles(func(file *models.File) bool { return file.HasUnstagedChanges })
+		files = self.FilterFiles(func(file *models.File) bool { return file.HasUnstagedChanges })
 	case DisplayConflicted:
-		return self.FilterFiles(func(file *models.File) bool { return file.HasMergeConflicts })
+		files = self.FilterFiles(func(file *models.File) bool { return file.HasMergeConflicts })
 	default:
 		panic(fmt.Sprintf("Unexpected files display filter: %d", self.filter))
 	}
+
+	return filterFiles(self.fileFilter, files, func(file *models.File) string { return file.Name }, fileChangeTypes)
 }
 
 func (self *FileTree) FilterFiles(test func(*models.File) bool) []*models.File {
@@ -96,6 +102,11 @@ func (self *FileTree) SetFilter(filter FileTreeDisplayFilter) {
 	self.SetTree()
 }
 
+func (self *FileTree) SetFileFilter(filter FileFilter) {
+	self.fileFilter = filter
+	self.SetTree()
+}
+
 func (self *FileTree) ToggleShowTree() {
 	self.showTree = !self.showTree
 	self.SetTree()
@@ -175,3 +186,7 @@ func (self *FileTree) CollapsedPaths() *CollapsedPaths {
 func (self *FileTree) GetFilter() FileTreeDisplayFilter {
 	return self.filter
 }
+
+func (self *FileTree) GetFileFilter() FileFilter {
+	return self.fileFilter
+}
@@ -79,3 +79,89 @@ func TestFilterAction(t *testing.T) {
 		})
 	}
 }
+
+func TestFileFilter(t *testing.T) {
+	files := []*models.File{
+		{Name: "dir/main.go", ShortStatus: " M", Tracked: true, HasUnstagedChanges: true},
+		{Name: "dir/main_test.go", ShortStatus: "A ", Tracked: true, Added: true, HasStagedChanges: true},
+		{Name: "docs/README.md", ShortStatus: " D", Tracked: true, Deleted: true, HasUnstagedChanges: true},
+		{Name: "new.txt", ShortStatus: "??", Added: true, HasUnstagedChanges: true},
+		{Name: "conflict.go", ShortStatus: "UU", Tracked: true, HasMergeConflicts: true},
+	}
+
+	scenarios := []struct {
+		name          string
+		displayFilter FileTreeDisplayFilter
+		fileFilter    FileFilter
+		expected      []string
+	}{
+		{
+			name:       "no filter",
+			fileFilter: FileFilter{},
+			expected:   []string{"dir/main.go", "dir/main_test.go", "docs/README.md", "new.txt", "conflict.go"},
+		},
+		{
+			name:       "glob matching file names",
+			fileFilter: FileFilter{Text: "*.go"},
+			expected:   []string{"dir/main.go", "dir/main_test.go", "conflict.go"},
+		},
+		{
+			name:       "glob matching paths",
+			fileFilter: FileFilter{Text: "d*/*_test.go"},
+			expected:   []string{"dir/main_test.go"},
+		},
+		{
+			name:       "fuzzy text",
+			fileFilter: FileFilter{Text: "readme"},
+			expected:   []string{"docs/README.md"},
+		},
+		{
+			name:       "change types",
+			fileFilter: FileFilter{ChangeTypes: []ChangeType{CHANGE_TYPE_ADDED, CHANGE_TYPE_UNTRACKED}},
+			expected:   []string{"dir/main_test.go", "new.txt"},
+		},
+		{
+			name:       "conflicted and modified",
+			fileFilter: FileFilter{ChangeTypes: []ChangeType{CHANGE_TYPE_CONFLICTED, CHANGE_TYPE_MODIFIED}},
+			expected:   []string{"dir/main.go", "conflict.go"},
+		},
+		{
+			name:       "text and change types",
+			fileFilter: FileFilter{Text: "*.go", ChangeTypes: []ChangeType{CHANGE_TYPE_MODIFIED}},
+			expected:   []string{"dir/main.go"},
+		},
+		{
+			name:          "combined with the display filter",
+			displayFilter: DisplayUnstaged,
+			fileFilter:    FileFilter{Text: "*.go"},
+			expected:      []string{"dir/main.go"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			mngr := &FileTree{getFiles: func() []*models.File { return files }, filter: s.displayFilter, fileFilter: s.fileFilter}
+			result := mngr.getFilesForDisplay()
+			names := make([]string, 0, len(result))
+			for _, file := range result {
+				names = append(names, file.Name)
+			}
+			assert.EqualValues(t, s.expected, names)
+		})
+	}
+}
+
+func TestCommitFileTreeFileFilter(t *testing.T) {
+	files := []*models.CommitFile{
+		{Name: "added.go", ChangeStatus: "A"},
+		{Name: "modified.go", ChangeStatus: "M"},
+		{Name: "deleted.txt", ChangeStatus: "D"},
+	}
+
+	tree := NewCommitFileTree(func() []*models.CommitFile { return files }, nil, false)
+	tree.SetFileFilter(FileFilter{Text: "*.go", ChangeTypes: []ChangeType{CHANGE_TYPE_DELETED, CHANGE_TYPE_MODIFIED}})
+
+	assert.Equal(t, 1, tree.Len())
+	assert.Equal(t, "modified.go", tree.Get(0).GetPath())
+}
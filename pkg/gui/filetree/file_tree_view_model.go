@@ -131,6 +131,11 @@ func (self *FileTreeViewModel) SetFilter(filter FileTreeDisplayFilter) {
 	self.IListCursor.SetSelectedLineIdx(0)
 }
 
+func (self *FileTreeViewModel) SetFileFilter(filter FileFilter) {
+	self.IFileTree.SetFileFilter(filter)
+	self.IListCursor.SetSelectedLineIdx(0)
+}
+
 // If we're going from flat to tree we want to select the same file.
 // If we're going from tree to flat and we have a file selected we want to select that.
 // If instead we've selected a directory we need to select the first file in that directory.
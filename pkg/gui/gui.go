@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/gocui"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
 	"github.com/jesseduffield/lazygit/pkg/commands"
@@ -24,6 +25,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/splitting"
 	"github.com/jesseduffield/lazygit/pkg/gui/popup"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
@@ -199,6 +201,8 @@ type GuiRepoState struct {
 	ScreenMode WindowMaximisation
 
 	CurrentPopupOpts *types.CreatePopupPanelOpts
+
+	BackgroundFetcher *backgroundFetcher
 }
 
 type searchingState struct {
@@ -227,9 +231,14 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, reuseState bool) error {
 		return err
 	}
 
+	if err := gui.git.Patch.RestoreCustomPatch(); err != nil {
+		gui.c.Log.Error(err)
+	}
+
 	gui.resetState(startArgs, reuseState)
 
 	gui.resetControllers()
+	gui.helpers.FileFilter.RestoreFilters()
 
 	if err := gui.resetKeybindings(); err != nil {
 		return err
@@ -278,25 +287,28 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs, reuseState bool) {
 
 	gui.State = &GuiRepoState{
 		Model: &types.Model{
-			CommitFiles:           nil,
-			Files:                 make([]*models.File, 0),
-			Commits:               make([]*models.Commit, 0),
-			StashEntries:          make([]*models.StashEntry, 0),
-			FilteredReflogCommits: make([]*models.Commit, 0),
-			ReflogCommits:         make([]*models.Commit, 0),
-			BisectInfo:            git_commands.NewNullBisectInfo(),
-			FilesTrie:             patricia.NewTrie(),
+			CommitFiles:            nil,
+			Files:                  make([]*models.File, 0),
+			Commits:                make([]*models.Commit, 0),
+			StashEntries:           make([]*models.StashEntry, 0),
+			FilteredReflogCommits:  make([]*models.Commit, 0),
+			ReflogCommits:          make([]*models.Commit, 0),
+			BisectInfo:             git_commands.NewNullBisectInfo(),
+			FilesTrie:              patricia.NewTrie(),
+			BranchesWithNewCommits: set.New[string](),
 		},
 		Modes: &types.Modes{
 			Filtering:     filtering.New(startArgs.FilterPath),
 			CherryPicking: cherrypicking.New(),
 			Diffing:       diffing.New(),
+			Splitting:     splitting.New(),
 		},
 		ScreenMode: initialScreenMode,
 		// TODO: put contexts in the context manager
 		ContextManager:    NewContextManager(initialContext),
 		Contexts:          contextTree,
 		WindowViewNameMap: initialWindowViewNameMap,
+		BackgroundFetcher: newBackgroundFetcher(),
 	}
 
 	gui.RepoStateMap[Repo(currentDir)] = gui.State
@@ -530,7 +542,7 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 
 	if userConfig.Git.AutoFetch {
 		fetchInterval := userConfig.Refresher.FetchInterval
-		if fetchInterval > 0 {
+		if autoFetchConfigured(userConfig.Refresher) {
 			go utils.Safe(gui.startBackgroundFetch)
 		} else {
 			gui.c.Log.Errorf(
@@ -571,6 +583,8 @@ func (gui *Gui) RunAndHandleError(startArgs appTypes.StartArgs) error {
 
 			switch err {
 			case gocui.ErrQuit:
+				gui.saveCustomPatch()
+
 				if gui.RetainOriginalDir {
 					if err := gui.recordDirectory(gui.InitialDir); err != nil {
 						return err
@@ -736,25 +750,6 @@ func (gui *Gui) goEvery(interval time.Duration, stop chan struct{}, function fun
 	})
 }
 
-func (gui *Gui) startBackgroundFetch() {
-	gui.waitForIntro.Wait()
-	isNew := gui.IsNewRepo
-	userConfig := gui.UserConfig
-	if !isNew {
-		time.After(time.Duration(userConfig.Refresher.FetchInterval) * time.Second)
-	}
-	err := gui.backgroundFetch()
-	if err != nil && strings.Contains(err.Error(), "exit status 128") && isNew {
-		_ = gui.c.Alert(gui.c.Tr.NoAutomaticGitFetchTitle, gui.c.Tr.NoAutomaticGitFetchBody)
-	} else {
-		gui.goEvery(time.Second*time.Duration(userConfig.Refresher.FetchInterval), gui.stopChan, func() error {
-			err := gui.backgroundFetch()
-			gui.render()
-			return err
-		})
-	}
-}
-
 // setColorScheme sets the color scheme for the app based on the user config
 func (gui *Gui) setColorScheme() error {
 	userConfig := gui.UserConfig
@@ -3,6 +3,7 @@ package gui
 import (
 	"log"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -45,11 +46,18 @@ func (gui *Gui) branchesListContext() *context.BranchesContext {
 		func() []*models.Branch { return gui.State.Model.Branches },
 		gui.Views.Branches,
 		func(startIdx int, length int) [][]string {
-			return presentation.GetBranchListDisplayStrings(gui.State.Model.Branches, gui.State.ScreenMode != SCREEN_NORMAL, gui.State.Modes.Diffing.Ref, gui.Tr)
+			return presentation.GetBranchListDisplayStrings(gui.State.Model.Branches, gui.State.ScreenMode != SCREEN_NORMAL, gui.State.Modes.Diffing.Ref, gui.State.Model.BranchesWithNewCommits, gui.Tr)
 		},
 		nil,
 		gui.withDiffModeCheck(gui.branchesRenderToMain),
-		nil,
+		func(types.OnFocusLostOpts) error {
+			if len(gui.State.Model.BranchesWithNewCommits.ToSlice()) == 0 {
+				return nil
+			}
+
+			gui.State.Model.BranchesWithNewCommits = set.New[string]()
+			return gui.c.PostRefreshUpdate(gui.State.Contexts.Branches)
+		},
 		gui.c,
 	)
 }
@@ -97,7 +105,12 @@ func (gui *Gui) tagsListContext() *context.TagsContext {
 		func() []*models.Tag { return gui.State.Model.Tags },
 		gui.Views.Tags,
 		func(startIdx int, length int) [][]string {
-			return presentation.GetTagListDisplayStrings(gui.State.Model.Tags, gui.State.Modes.Diffing.Ref)
+			return presentation.GetTagListDisplayStrings(
+				gui.State.Model.Tags,
+				gui.State.ScreenMode != SCREEN_NORMAL,
+				gui.State.Modes.Diffing.Ref,
+				gui.c.UserConfig.Gui.TimeFormat,
+			)
 		},
 		nil,
 		gui.withDiffModeCheck(gui.tagsRenderToMain),
@@ -252,7 +265,7 @@ func (gui *Gui) submodulesListContext() *context.SubmodulesContext {
 		func() []*models.SubmoduleConfig { return gui.State.Model.Submodules },
 		gui.Views.Submodules,
 		func(startIdx int, length int) [][]string {
-			return presentation.GetSubmoduleListDisplayStrings(gui.State.Model.Submodules)
+			return presentation.GetSubmoduleListDisplayStrings(gui.State.Model.Submodules, gui.Tr)
 		},
 		nil,
 		gui.withDiffModeCheck(gui.submodulesRenderToMain),
@@ -74,7 +74,8 @@ func (gui *Gui) patchBuildingMainContextPair() types.MainContextPair {
 func (gui *Gui) mergingMainContextPair() types.MainContextPair {
 	return types.NewMainContextPair(
 		gui.State.Contexts.MergeConflicts,
-		nil,
+		// only shown in the three-way view
+		gui.State.Contexts.NormalSecondary,
 	)
 }
 
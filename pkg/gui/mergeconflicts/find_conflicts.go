@@ -115,3 +115,23 @@ func fileHasConflictMarkersAux(file io.Reader) bool {
 
 	return false
 }
+
+// FileIsBinary tells us whether a file is binary in the way git does, by looking
+// for a NUL byte near the start of it
+func FileIsBinary(path string) bool {
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+
+	defer file.Close()
+
+	return readerIsBinary(file)
+}
+
+func readerIsBinary(reader io.Reader) bool {
+	buf := make([]byte, 8000)
+	n, _ := io.ReadFull(reader, buf)
+
+	return bytes.IndexByte(buf[:n], 0) != -1
+}
@@ -99,3 +99,34 @@ func TestFindConflictsAux(t *testing.T) {
 		assert.EqualValues(t, s.expected, fileHasConflictMarkersAux(reader))
 	}
 }
+
+func TestReaderIsBinary(t *testing.T) {
+	type scenario struct {
+		content  string
+		expected bool
+	}
+
+	scenarios := []scenario{
+		{
+			content:  "",
+			expected: false,
+		},
+		{
+			content:  "blah\nblah\n",
+			expected: false,
+		},
+		{
+			content:  "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR",
+			expected: true,
+		},
+		{
+			content:  strings.Repeat("a", 8000) + "\x00",
+			expected: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		reader := strings.NewReader(s.content)
+		assert.EqualValues(t, s.expected, readerIsBinary(reader))
+	}
+}
@@ -2,10 +2,12 @@ package mergeconflicts
 
 import (
 	"bytes"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/mattn/go-runewidth"
 )
 
 func ColoredConflictFile(state *State, hasFocus bool) string {
@@ -21,7 +23,11 @@ func ColoredConflictFile(state *State, hasFocus bool) string {
 			textStyle = style.FgRed
 		}
 
-		if hasFocus && state.conflictIndex < len(state.conflicts) && *state.conflicts[state.conflictIndex] == *conflict && shouldHighlightLine(i, conflict, state.Selection()) {
+		if state.threeWay {
+			if hasFocus && i == state.lineIndex {
+				textStyle = textStyle.MergeStyle(theme.SelectedRangeBgColor).SetBold()
+			}
+		} else if hasFocus && state.conflictIndex < len(state.conflicts) && *state.conflicts[state.conflictIndex] == *conflict && shouldHighlightLine(i, conflict, state.Selection()) {
 			textStyle = textStyle.MergeStyle(theme.SelectedRangeBgColor).SetBold()
 		}
 		if i == conflict.end && len(remainingConflicts) > 0 {
@@ -32,6 +38,58 @@ func ColoredConflictFile(state *State, hasFocus bool) string {
 	return outputBuffer.String()
 }
 
+// ColoredThreeWaySides renders the ours, base and theirs versions of the selected
+// conflict in columns, highlighting the one that the selected line is in
+func ColoredThreeWaySides(state *State, width int) string {
+	sides, ok := state.currentSides()
+	if !ok {
+		return ""
+	}
+
+	separator := style.FgBlue.Sprint(" │ ")
+	columnWidth := utils.Max((width-2*runewidth.StringWidth(" │ "))/3, 1)
+	selected := state.Selection()
+
+	columns := []struct {
+		selection Selection
+		title     string
+		lines     []string
+	}{
+		{TOP, "ours", sides.ours},
+		{MIDDLE, "base", sides.base},
+		{BOTTOM, "theirs", sides.theirs},
+	}
+
+	rowCount := 0
+	for _, column := range columns {
+		rowCount = utils.Max(rowCount, len(column.lines))
+	}
+
+	var outputBuffer bytes.Buffer
+	for row := -1; row < rowCount; row++ {
+		cells := make([]string, 0, len(columns))
+		for _, column := range columns {
+			textStyle := theme.DefaultTextColor
+			if column.selection == selected {
+				textStyle = style.FgGreen
+			}
+
+			text := ""
+			if row == -1 {
+				text = column.title
+				textStyle = textStyle.SetBold()
+			} else if row < len(column.lines) {
+				text = strings.ReplaceAll(strings.TrimRight(column.lines[row], "\r\n"), "\t", "    ")
+			}
+
+			cells = append(cells, textStyle.Sprint(utils.WithPadding(utils.TruncateWithEllipsis(text, columnWidth), columnWidth)))
+		}
+		outputBuffer.WriteString(strings.Join(cells, separator) + "\n")
+	}
+
+	return outputBuffer.String()
+}
+
 func shiftConflict(conflicts []*mergeConflict) (*mergeConflict, []*mergeConflict) {
 	return conflicts[0], conflicts[1:]
 }
@@ -20,6 +20,14 @@ type State struct {
 	// this is the index of the selected conflict's available selections slice e.g. [TOP, MIDDLE, BOTTOM]
 	// We use this to know which hunk of the conflict is selected.
 	selectionIndex int
+
+	// whether we're showing the three-way view (see three_way.go)
+	threeWay bool
+	// the base, ours and theirs versions of each conflict in the file at sidesPath
+	sides     []conflictSides
+	sidesPath string
+	// the selected line in the three-way view
+	lineIndex int
 }
 
 func NewState() *State {
@@ -56,13 +64,39 @@ func (s *State) SelectPrevConflictHunk() {
 
 func (s *State) SelectNextConflict() {
 	s.setConflictIndex(s.conflictIndex + 1)
+	s.selectConflictStartLine()
 }
 
 func (s *State) SelectPrevConflict() {
 	s.setConflictIndex(s.conflictIndex - 1)
+	s.selectConflictStartLine()
 }
 
+func (s *State) selectConflictStartLine() {
+	if conflict := s.conflictAtIndex(); conflict != nil && s.threeWay {
+		s.setLineIndex(conflict.start)
+	}
+}
+
+// currentConflict returns the conflict that the user is acting on. In the
+// three-way view that's the conflict containing the selected line, so if the
+// line isn't in a conflict there's no current conflict.
 func (s *State) currentConflict() *mergeConflict {
+	conflict := s.conflictAtIndex()
+	if conflict == nil {
+		return nil
+	}
+
+	if s.threeWay && (s.lineIndex < conflict.start || s.lineIndex > conflict.end) {
+		return nil
+	}
+
+	return conflict
+}
+
+// conflictAtIndex returns the conflict at conflictIndex regardless of the
+// selected line. In the three-way view this is the conflict we last moved to.
+func (s *State) conflictAtIndex() *mergeConflict {
 	if len(s.conflicts) == 0 {
 		return nil
 	}
@@ -78,6 +112,7 @@ func (s *State) SetContent(content string, path string) {
 
 	s.path = path
 	s.contents = []string{}
+	s.lineIndex = 0
 	s.PushContent(content)
 }
 
@@ -117,6 +152,9 @@ func (s *State) Undo() bool {
 func (s *State) setConflicts(conflicts []*mergeConflict) {
 	s.conflicts = conflicts
 	s.setConflictIndex(s.conflictIndex)
+	if s.threeWay {
+		s.setLineIndex(s.lineIndex)
+	}
 }
 
 func (s *State) NoConflicts() bool {
@@ -124,6 +162,9 @@ func (s *State) NoConflicts() bool {
 }
 
 func (s *State) Selection() Selection {
+	if s.threeWay {
+		return s.selectionAtLine()
+	}
 	if selections := s.availableSelections(); len(selections) > 0 {
 		return selections[s.selectionIndex]
 	}
@@ -144,6 +185,8 @@ func (s *State) AllConflictsResolved() bool {
 func (s *State) Reset() {
 	s.contents = []string{}
 	s.path = ""
+	s.sides = nil
+	s.sidesPath = ""
 }
 
 // we're not resetting selectedIndex here because the user typically would want
@@ -157,6 +200,10 @@ func (s *State) Active() bool {
 }
 
 func (s *State) GetConflictMiddle() int {
+	if s.threeWay {
+		return s.lineIndex
+	}
+
 	currentConflict := s.currentConflict()
 
 	if currentConflict == nil {
@@ -186,6 +233,10 @@ func (s *State) ContentAfterConflictResolve(selection Selection) (bool, string,
 }
 
 func (s *State) GetSelectedLine() int {
+	if s.threeWay {
+		return s.lineIndex + 1
+	}
+
 	conflict := s.currentConflict()
 	if conflict == nil {
 		return 1
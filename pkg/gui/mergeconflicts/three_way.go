@@ -0,0 +1,216 @@
+package mergeconflicts
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// In the three-way view we show the ours, base and theirs versions of the selected
+// conflict next to the file (the result), and the user moves through the result
+// line by line rather than hunk by hunk. The file only has a base section if the
+// user has set merge.conflictStyle=diff3, so we get the base versions by redoing
+// the merge from the file's index stages (see SetThreeWaySides).
+
+// conflictSides holds the ours, base and theirs versions of a conflict. Each line
+// keeps its trailing newline.
+type conflictSides struct {
+	ours   []string
+	base   []string
+	theirs []string
+}
+
+func (s conflictSides) side(selection Selection) []string {
+	switch selection {
+	case TOP:
+		return s.ours
+	case MIDDLE:
+		return s.base
+	case BOTTOM:
+		return s.theirs
+	case ALL:
+		return append(append([]string{}, s.ours...), s.theirs...)
+	}
+
+	panic("unexpected selection for merge conflict")
+}
+
+// sidesFromDiff3 returns the sides of each conflict in content which has diff3
+// style conflict markers
+func sidesFromDiff3(content string) []conflictSides {
+	lines := splitLinesKeepingNewlines(content)
+
+	return slices.FilterMap(findConflicts(content), func(conflict *mergeConflict) (conflictSides, bool) {
+		if !conflict.hasAncestor() {
+			return conflictSides{}, false
+		}
+
+		return conflictSides{
+			ours:   lines[conflict.start+1 : conflict.ancestor],
+			base:   lines[conflict.ancestor+1 : conflict.target],
+			theirs: lines[conflict.target+1 : conflict.end],
+		}, true
+	})
+}
+
+func splitLinesKeepingNewlines(content string) []string {
+	if content == "" {
+		return []string{}
+	}
+
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func (s *State) ToggleThreeWay() {
+	s.threeWay = !s.threeWay
+	if conflict := s.conflictAtIndex(); conflict != nil {
+		s.setLineIndex(conflict.start)
+	}
+}
+
+func (s *State) ThreeWay() bool {
+	return s.threeWay
+}
+
+// NeedsThreeWaySides tells us whether we've yet to get the base, ours and theirs
+// versions of the current file's conflicts
+func (s *State) NeedsThreeWaySides() bool {
+	return s.sidesPath != s.path
+}
+
+// SetThreeWaySides takes the result of redoing the merge of the current file with
+// diff3 style conflict markers
+func (s *State) SetThreeWaySides(diff3Content string) {
+	s.sides = sidesFromDiff3(diff3Content)
+	s.sidesPath = s.path
+}
+
+// currentSides returns the sides of the selected conflict. Ours and theirs come
+// from the file itself, as does the base if the file has diff3 style markers.
+// Otherwise we look for the conflict among the ones we got by redoing the merge,
+// going by its ours and theirs lines, or failing that by its position.
+func (s *State) currentSides() (conflictSides, bool) {
+	conflict := s.currentConflict()
+	if conflict == nil {
+		return conflictSides{}, false
+	}
+
+	lines := splitLinesKeepingNewlines(s.GetContent())
+	topEnd := conflict.target
+	if conflict.hasAncestor() {
+		topEnd = conflict.ancestor
+	}
+	sides := conflictSides{
+		ours:   lines[conflict.start+1 : topEnd],
+		theirs: lines[conflict.target+1 : conflict.end],
+	}
+
+	if conflict.hasAncestor() {
+		sides.base = lines[conflict.ancestor+1 : conflict.target]
+		return sides, true
+	}
+
+	for _, candidate := range s.sides {
+		if slices.Equal(candidate.ours, sides.ours) && slices.Equal(candidate.theirs, sides.theirs) {
+			sides.base = candidate.base
+			return sides, true
+		}
+	}
+
+	if len(s.sides) == len(s.conflicts) {
+		sides.base = s.sides[s.conflictIndex].base
+	}
+
+	return sides, true
+}
+
+// ContentAfterPickingSide returns the content with the selected conflict replaced
+// by the given side. Unlike ContentAfterConflictResolve this doesn't need the file
+// to have a base section in order to pick the base.
+func (s *State) ContentAfterPickingSide(selection Selection) (bool, string) {
+	conflict := s.currentConflict()
+	sides, ok := s.currentSides()
+	if !ok {
+		return false, ""
+	}
+
+	lines := splitLinesKeepingNewlines(s.GetContent())
+	newLines := append(append(append([]string{}, lines[:conflict.start]...), sides.side(selection)...), lines[conflict.end+1:]...)
+
+	return true, strings.Join(newLines, "")
+}
+
+func (s *State) setLineIndex(index int) {
+	lineCount := len(splitLinesKeepingNewlines(s.GetContent()))
+	s.lineIndex = utils.Clamp(index, 0, utils.Max(lineCount-1, 0))
+
+	// the selected conflict follows the selected line
+	for i, conflict := range s.conflicts {
+		if conflict.start <= s.lineIndex && s.lineIndex <= conflict.end {
+			s.conflictIndex = i
+			break
+		}
+	}
+}
+
+func (s *State) SelectPrevLine() {
+	s.setLineIndex(s.lineIndex - 1)
+}
+
+func (s *State) SelectNextLine() {
+	s.setLineIndex(s.lineIndex + 1)
+}
+
+// SelectedLine returns the selected line of the result, without its newline
+func (s *State) SelectedLine() string {
+	lines := splitLinesKeepingNewlines(s.GetContent())
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return strings.TrimRight(lines[s.lineIndex], "\r\n")
+}
+
+// ContentAfterEditingLine returns the content with the selected line replaced
+func (s *State) ContentAfterEditingLine(newLine string) string {
+	lines := splitLinesKeepingNewlines(s.GetContent())
+	if len(lines) == 0 {
+		return newLine + "\n"
+	}
+
+	oldLine := lines[s.lineIndex]
+	lines[s.lineIndex] = newLine + oldLine[len(strings.TrimRight(oldLine, "\r\n")):]
+
+	return strings.Join(lines, "")
+}
+
+// ContentAfterDeletingLine returns the content with the selected line removed
+func (s *State) ContentAfterDeletingLine() string {
+	lines := splitLinesKeepingNewlines(s.GetContent())
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return strings.Join(append(lines[:s.lineIndex:s.lineIndex], lines[s.lineIndex+1:]...), "")
+}
+
+// selectionAtLine returns the section of the selected conflict that the selected
+// line is in, so that in the three-way view we can pick a hunk by moving to it
+func (s *State) selectionAtLine() Selection {
+	conflict := s.currentConflict()
+	switch {
+	case conflict == nil:
+		return TOP
+	case s.lineIndex >= conflict.target:
+		return BOTTOM
+	case conflict.hasAncestor() && s.lineIndex >= conflict.ancestor:
+		return MIDDLE
+	default:
+		return TOP
+	}
+}
@@ -0,0 +1,164 @@
+package mergeconflicts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const twoWayContent = `before
+<<<<<<< HEAD
+ours
+=======
+theirs
+>>>>>>> branch
+middle
+<<<<<<< HEAD
+ours 2
+=======
+theirs 2
+>>>>>>> branch
+after
+`
+
+const diff3Content = `before
+<<<<<<< ours
+ours
+||||||| base
+base
+=======
+theirs
+>>>>>>> theirs
+middle
+<<<<<<< ours
+ours 2
+||||||| base
+base 2
+=======
+theirs 2
+>>>>>>> theirs
+after
+`
+
+func TestPickingSideInThreeWayView(t *testing.T) {
+	type scenario struct {
+		name      string
+		content   string
+		selection Selection
+		expected  string
+	}
+
+	scenarios := []scenario{
+		{
+			name:      "base without diff3 markers",
+			content:   twoWayContent,
+			selection: MIDDLE,
+			expected:  "before\nbase\nmiddle\n<<<<<<< HEAD\nours 2\n=======\ntheirs 2\n>>>>>>> branch\nafter\n",
+		},
+		{
+			name:      "theirs",
+			content:   twoWayContent,
+			selection: BOTTOM,
+			expected:  "before\ntheirs\nmiddle\n<<<<<<< HEAD\nours 2\n=======\ntheirs 2\n>>>>>>> branch\nafter\n",
+		},
+		{
+			name:      "both",
+			content:   twoWayContent,
+			selection: ALL,
+			expected:  "before\nours\ntheirs\nmiddle\n<<<<<<< HEAD\nours 2\n=======\ntheirs 2\n>>>>>>> branch\nafter\n",
+		},
+		{
+			name:      "base with diff3 markers",
+			content:   diff3Content,
+			selection: MIDDLE,
+			expected:  "before\nbase\nmiddle\n<<<<<<< ours\nours 2\n||||||| base\nbase 2\n=======\ntheirs 2\n>>>>>>> theirs\nafter\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			state := NewState()
+			state.SetContent(s.content, "file.txt")
+			state.ToggleThreeWay()
+			assert.True(t, state.NeedsThreeWaySides())
+			state.SetThreeWaySides(diff3Content)
+			assert.False(t, state.NeedsThreeWaySides())
+
+			ok, content := state.ContentAfterPickingSide(s.selection)
+			assert.True(t, ok)
+			assert.Equal(t, s.expected, content)
+		})
+	}
+}
+
+func TestThreeWaySidesAfterResolvingEarlierConflict(t *testing.T) {
+	state := NewState()
+	state.SetContent(twoWayContent, "file.txt")
+	state.SetThreeWaySides(diff3Content)
+
+	// now there's one conflict left but we still find its base by its ours and theirs lines
+	state.PushContent("before\nours\nmiddle\n<<<<<<< HEAD\nours 2\n=======\ntheirs 2\n>>>>>>> branch\nafter\n")
+
+	sides, ok := state.currentSides()
+	assert.True(t, ok)
+	assert.Equal(t, conflictSides{
+		ours:   []string{"ours 2\n"},
+		base:   []string{"base 2\n"},
+		theirs: []string{"theirs 2\n"},
+	}, sides)
+}
+
+func TestEditingLinesInThreeWayView(t *testing.T) {
+	state := NewState()
+	state.SetContent(twoWayContent, "file.txt")
+	state.ToggleThreeWay()
+	assert.Equal(t, 2, state.GetSelectedLine())
+
+	state.SelectNextLine()
+	assert.Equal(t, "ours", state.SelectedLine())
+	assert.Equal(t, TOP, state.Selection())
+
+	state.SelectNextLine()
+	state.SelectNextLine()
+	assert.Equal(t, "theirs", state.SelectedLine())
+	assert.Equal(t, BOTTOM, state.Selection())
+
+	state.PushContent(state.ContentAfterEditingLine("mine and theirs"))
+	assert.Equal(t, "mine and theirs", state.SelectedLine())
+
+	state.PushContent(state.ContentAfterDeletingLine())
+	assert.Equal(t, ">>>>>>> branch", state.SelectedLine())
+
+	state.SelectNextConflict()
+	assert.Equal(t, "<<<<<<< HEAD", state.SelectedLine())
+	assert.Equal(t, 7, state.GetSelectedLine())
+
+	assert.True(t, state.Undo())
+	assert.True(t, state.Undo())
+	assert.Equal(t, twoWayContent, state.GetContent())
+}
+
+func TestPickingOutsideConflictsInThreeWayView(t *testing.T) {
+	state := NewState()
+	state.SetContent(twoWayContent, "file.txt")
+	state.SetThreeWaySides(diff3Content)
+	state.ToggleThreeWay()
+
+	// move from the first conflict to the line between the two conflicts
+	for i := 0; i < 5; i++ {
+		state.SelectNextLine()
+	}
+	assert.Equal(t, "middle", state.SelectedLine())
+
+	ok, _ := state.ContentAfterPickingSide(BOTTOM)
+	assert.False(t, ok)
+	ok, _, err := state.ContentAfterConflictResolve(BOTTOM)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	state.SelectNextLine()
+	ok, content := state.ContentAfterPickingSide(BOTTOM)
+	assert.True(t, ok)
+	assert.Equal(t, "before\n<<<<<<< HEAD\nours\n=======\ntheirs\n>>>>>>> branch\nmiddle\ntheirs 2\nafter\n", content)
+}
@@ -50,6 +50,20 @@ func (gui *Gui) modeStatuses() []modeStatus {
 			},
 			reset: gui.exitFilterMode,
 		},
+		{
+			isActive: gui.helpers.FileFilter.Active,
+			description: func() string {
+				return gui.withResetButton(
+					fmt.Sprintf(
+						"%s %s",
+						gui.c.Tr.LcFilteringFiles,
+						gui.helpers.FileFilter.Description(),
+					),
+					style.FgRed,
+				)
+			},
+			reset: gui.helpers.FileFilter.Reset,
+		},
 		{
 			isActive: gui.State.Modes.CherryPicking.Active,
 			description: func() string {
@@ -63,6 +77,20 @@ func (gui *Gui) modeStatuses() []modeStatus {
 			},
 			reset: gui.helpers.CherryPick.Reset,
 		},
+		{
+			isActive: gui.helpers.SplitCommit.Active,
+			description: func() string {
+				return gui.withResetButton(
+					fmt.Sprintf(
+						"%s %s",
+						gui.c.Tr.LcSplittingCommit,
+						gui.helpers.SplitCommit.Commit().ShortSha(),
+					),
+					style.FgYellow,
+				)
+			},
+			reset: gui.helpers.MergeAndRebase.AbortMergeOrRebaseWithConfirm,
+		},
 		{
 			isActive: func() bool {
 				return gui.git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE
@@ -0,0 +1,38 @@
+package splitting
+
+import (
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+// Splitting holds the state of a commit that is being split into several
+// commits. While splitting, we're mid-rebase, stopped at the commit's parent
+// with the commit's changes in the working tree. Each time the user commits,
+// we check whether anything is left, and once nothing is, we continue the rebase.
+type Splitting struct {
+	// the commit being split, or nil if we're not splitting
+	Commit *models.Commit
+	// the original commit message, used to pre-fill the commit message panel
+	OriginalMessage string
+	// untracked files that existed before we started splitting. These were not
+	// part of the commit so we don't wait for them to be committed.
+	PreExistingUntrackedFiles *set.Set[string]
+}
+
+func New() *Splitting {
+	return &Splitting{}
+}
+
+func (m *Splitting) Active() bool {
+	return m.Commit != nil
+}
+
+func (m *Splitting) Start(commit *models.Commit, originalMessage string, preExistingUntrackedFiles []string) {
+	m.Commit = commit
+	m.OriginalMessage = originalMessage
+	m.PreExistingUntrackedFiles = set.NewFromSlice(preExistingUntrackedFiles)
+}
+
+func (m *Splitting) Reset() {
+	*m = Splitting{}
+}
@@ -14,6 +14,9 @@ type State struct {
 	diff              string
 	patchParser       *patch.PatchParser
 	selectMode        selectMode
+	// set when we last rendered the diff side by side, in which case lines of the
+	// view don't line up with lines of the patch
+	sideBySide *patch.SideBySideView
 }
 
 // these represent what select mode we're in
@@ -33,6 +36,12 @@ func NewState(diff string, selectedLineIdx int, oldState *State, log *logrus.Ent
 		return oldState
 	}
 
+	// if the user clicked on the view we need to know which line of the patch
+	// they clicked on
+	if oldState != nil && selectedLineIdx >= 0 {
+		selectedLineIdx = oldState.PatchLineIdx(selectedLineIdx)
+	}
+
 	patchParser := patch.NewPatchParser(log, diff)
 
 	if len(patchParser.StageableLines) == 0 {
@@ -185,9 +194,39 @@ func (s *State) AdjustSelectedLineIdx(change int) {
 	s.SelectLine(s.selectedLineIdx + change)
 }
 
-func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int) string {
+// RenderForLineIndices renders the diff, laid out side by side in the given width
+// unless it's zero
+func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int, wordDiff string, sideBySideWidth int, syntaxHighlighting bool) string {
 	firstLineIdx, lastLineIdx := s.SelectedRange()
-	return s.patchParser.Render(isFocused, firstLineIdx, lastLineIdx, includedLineIndices)
+	s.sideBySide = nil
+	if sideBySideWidth > 0 {
+		s.sideBySide = s.patchParser.RenderSideBySide(sideBySideWidth, isFocused, firstLineIdx, lastLineIdx, includedLineIndices, wordDiff, syntaxHighlighting)
+		if s.sideBySide != nil {
+			return s.sideBySide.Content
+		}
+	}
+
+	return s.patchParser.Render(isFocused, firstLineIdx, lastLineIdx, includedLineIndices, wordDiff, syntaxHighlighting)
+}
+
+// ViewLineIdx returns the line of the view that the given line of the patch was
+// last rendered at
+func (s *State) ViewLineIdx(patchLineIdx int) int {
+	if s.sideBySide == nil {
+		return patchLineIdx
+	}
+
+	return s.sideBySide.ViewLineIdx(patchLineIdx)
+}
+
+// PatchLineIdx returns the line of the patch that was last rendered at the given
+// line of the view
+func (s *State) PatchLineIdx(viewLineIdx int) int {
+	if s.sideBySide == nil {
+		return viewLineIdx
+	}
+
+	return s.sideBySide.PatchLineIdx(viewLineIdx)
 }
 
 func (s *State) PlainRenderSelected() string {
@@ -207,6 +246,16 @@ func (s *State) SelectTop() {
 
 func (s *State) CalculateOrigin(currentOrigin int, bufferHeight int) int {
 	firstLineIdx, lastLineIdx := s.SelectedRange()
+	selectedLineIdx := s.GetSelectedLineIdx()
+
+	if s.sideBySide != nil {
+		isFirst := selectedLineIdx == firstLineIdx
+		firstLineIdx, lastLineIdx = s.sideBySide.ViewLineRange(firstLineIdx, lastLineIdx)
+		selectedLineIdx = lastLineIdx
+		if isFirst {
+			selectedLineIdx = firstLineIdx
+		}
+	}
 
-	return calculateOrigin(currentOrigin, bufferHeight, firstLineIdx, lastLineIdx, s.GetSelectedLineIdx(), s.selectMode)
+	return calculateOrigin(currentOrigin, bufferHeight, firstLineIdx, lastLineIdx, selectedLineIdx, s.selectMode)
 }
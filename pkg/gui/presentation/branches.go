@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
@@ -15,15 +16,16 @@ import (
 
 var branchPrefixColorCache = make(map[string]style.TextStyle)
 
-func GetBranchListDisplayStrings(branches []*models.Branch, fullDescription bool, diffName string, tr *i18n.TranslationSet) [][]string {
+func GetBranchListDisplayStrings(branches []*models.Branch, fullDescription bool, diffName string, branchesWithNewCommits *set.Set[string], tr *i18n.TranslationSet) [][]string {
 	return slices.Map(branches, func(branch *models.Branch) []string {
 		diffed := branch.Name == diffName
-		return getBranchDisplayStrings(branch, fullDescription, diffed, tr)
+		hasNewCommits := branchesWithNewCommits.Includes(branch.Name)
+		return getBranchDisplayStrings(branch, fullDescription, diffed, hasNewCommits, tr)
 	})
 }
 
 // getBranchDisplayStrings returns the display string of branch
-func getBranchDisplayStrings(b *models.Branch, fullDescription bool, diffed bool, tr *i18n.TranslationSet) []string {
+func getBranchDisplayStrings(b *models.Branch, fullDescription bool, diffed bool, hasNewCommits bool, tr *i18n.TranslationSet) []string {
 	displayName := b.Name
 	if b.DisplayName != "" {
 		displayName = b.DisplayName
@@ -33,10 +35,19 @@ func getBranchDisplayStrings(b *models.Branch, fullDescription bool, diffed bool
 	if diffed {
 		nameTextStyle = theme.DiffTerminalColor
 	}
+	if hasNewCommits {
+		nameTextStyle = nameTextStyle.SetBold()
+	}
 
 	coloredName := nameTextStyle.Sprint(displayName)
 	branchStatus := utils.WithPadding(ColoredBranchStatus(b, tr), 2)
 	coloredName = fmt.Sprintf("%s %s", coloredName, branchStatus)
+	if hasNewCommits {
+		coloredName = fmt.Sprintf("%s %s", coloredName, style.FgCyan.Sprint(tr.NewCommitsFetchedMarker))
+	}
+	if b.HasDivergenceFromBase() {
+		coloredName = fmt.Sprintf("%s %s", coloredName, style.FgBlue.Sprint(DivergenceFromBase(b)))
+	}
 
 	recencyColor := style.FgCyan
 	if b.Recency == "  *" {
@@ -121,6 +132,20 @@ func BranchStatus(branch *models.Branch, tr *i18n.TranslationSet) string {
 	return result
 }
 
+// DivergenceFromBase returns e.g. '↑2↓5' for a branch two commits ahead of and
+// five commits behind the base branch
+func DivergenceFromBase(branch *models.Branch) string {
+	result := ""
+	if branch.AheadOfBase != "0" {
+		result = fmt.Sprintf("↑%s", branch.AheadOfBase)
+	}
+	if branch.BehindBase != "0" {
+		result = fmt.Sprintf("%s↓%s", result, branch.BehindBase)
+	}
+
+	return result
+}
+
 func SetCustomBranches(customBranchColors map[string]string) {
 	branchPrefixColorCache = utils.SetCustomColors(customBranchColors)
 }
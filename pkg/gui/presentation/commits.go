@@ -279,11 +279,13 @@ func displayCommit(
 		authorFunc = authors.LongAuthor
 	}
 
-	cols := make([]string, 0, 7)
+	cols := make([]string, 0, 8)
 	if icons.IsIconEnabled() {
 		cols = append(cols, shaColor.Sprint(icons.IconForCommit(commit)))
 	}
 	cols = append(cols, shaColor.Sprint(commit.ShortSha()))
+	cols = append(cols, getSignatureText(commit.SignatureStatus))
+	cols = append(cols, getDiffStatText(commit.LinesAdded, commit.LinesDeleted))
 	cols = append(cols, bisectString)
 	if fullDescription {
 		cols = append(cols, style.FgBlue.Sprint(utils.UnixToDate(commit.UnixTimestamp, timeFormat)))
@@ -298,6 +300,19 @@ func displayCommit(
 	return cols
 }
 
+func getSignatureText(status models.SignatureStatus) string {
+	switch status {
+	case models.SignatureStatusGood:
+		return style.FgGreen.Sprint("✓")
+	case models.SignatureStatusBad:
+		return style.FgRed.Sprint("✗")
+	case models.SignatureStatusUnknown:
+		return style.FgYellow.Sprint("?")
+	default:
+		return ""
+	}
+}
+
 func getBisectStatusColor(status BisectStatus) style.TextStyle {
 	switch status {
 	case BisectStatusNone:
@@ -363,6 +378,8 @@ func actionColorMap(str string) style.TextStyle {
 		return style.FgGreen
 	case "fixup":
 		return style.FgMagenta
+	case "exec", "break", "label", "reset", "merge":
+		return style.FgBlue
 	default:
 		return style.FgYellow
 	}
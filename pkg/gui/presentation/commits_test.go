@@ -205,6 +205,42 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 			sha2 pick  commit2
 				`),
 		},
+		{
+			testName: "signed commits",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1", SignatureStatus: models.SignatureStatusGood},
+				{Name: "commit2", Sha: "sha2", SignatureStatus: models.SignatureStatusBad},
+				{Name: "commit3", Sha: "sha3", SignatureStatus: models.SignatureStatusUnknown},
+				{Name: "commit4", Sha: "sha4"},
+			},
+			startIdx:                 0,
+			length:                   4,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			expected: formatExpected(`
+		sha1 ✓ commit1
+		sha2 ✗ commit2
+		sha3 ? commit3
+		sha4   commit4
+						`),
+		},
+		{
+			testName: "diff stats",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1", LinesAdded: 120, LinesDeleted: 3},
+				{Name: "commit2", Sha: "sha2"},
+			},
+			startIdx:                 0,
+			length:                   2,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			expected: formatExpected(`
+		sha1 +120 -3 ■■■ commit1
+		sha2             commit2
+						`),
+		},
 		{
 			testName: "custom time format",
 			commits: []*models.Commit{
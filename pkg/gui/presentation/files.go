@@ -1,6 +1,9 @@
 package presentation
 
 import (
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -17,6 +20,11 @@ const (
 	COLLAPSED_ARROW = "►"
 )
 
+const (
+	DIFF_STAT_BLOCK     = "■"
+	DIFF_STAT_BAR_WIDTH = 5
+)
+
 // keeping these here as individual constants in case later on people want the old tree shape
 const (
 	INNER_ITEM = "  "
@@ -33,7 +41,9 @@ func RenderFileTree(
 	return renderAux(tree.GetRoot().Raw(), tree.CollapsedPaths(), "", -1, func(node *filetree.Node[models.File], depth int) string {
 		fileNode := filetree.NewFileNode(node)
 
-		return getFileLine(fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), fileNameAtDepth(node, depth), diffName, submoduleConfigs, node.File)
+		linesAdded, linesDeleted := fileNode.GetDiffStat()
+
+		return getFileLine(fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), fileNameAtDepth(node, depth), diffName, submoduleConfigs, node.File, linesAdded, linesDeleted)
 	})
 }
 
@@ -60,7 +70,9 @@ func RenderCommitFileTree(
 			status = patch.PART
 		}
 
-		return getCommitFileLine(commitFileNameAtDepth(node, depth), diffName, node.File, status)
+		linesAdded, linesDeleted := filetree.NewCommitFileNode(node).GetDiffStat()
+
+		return getCommitFileLine(commitFileNameAtDepth(node, depth), diffName, node.File, status, linesAdded, linesDeleted)
 	})
 }
 
@@ -118,7 +130,7 @@ func renderAux[T any](
 	return arr
 }
 
-func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, diffName string, submoduleConfigs []*models.SubmoduleConfig, file *models.File) string {
+func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, diffName string, submoduleConfigs []*models.SubmoduleConfig, file *models.File, linesAdded int, linesDeleted int) string {
 	// potentially inefficient to be instantiating these color
 	// objects with each render
 	partiallyModifiedColor := style.FgYellow
@@ -128,6 +140,8 @@ func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, di
 		restColor = theme.DiffTerminalColor
 	} else if file == nil && hasStagedChanges && hasUnstagedChanges {
 		restColor = partiallyModifiedColor
+	} else if file != nil && file.ResolvedByRerere {
+		restColor = partiallyModifiedColor
 	} else if hasUnstagedChanges {
 		restColor = theme.UnstagedChangesColor
 	}
@@ -167,10 +181,18 @@ func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, di
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
 
+	if file != nil && file.ResolvedByRerere {
+		output += theme.DefaultTextColor.Sprint(" (resolved by rerere)")
+	}
+
+	if diffStat := getDiffStatText(linesAdded, linesDeleted); diffStat != "" {
+		output += " " + diffStat
+	}
+
 	return output
 }
 
-func getCommitFileLine(name string, diffName string, commitFile *models.CommitFile, status patch.PatchStatus) string {
+func getCommitFileLine(name string, diffName string, commitFile *models.CommitFile, status patch.PatchStatus, linesAdded int, linesDeleted int) string {
 	var colour style.TextStyle
 	if diffName == name {
 		colour = theme.DiffTerminalColor
@@ -200,9 +222,36 @@ func getCommitFileLine(name string, diffName string, commitFile *models.CommitFi
 	}
 
 	output += colour.Sprint(name)
+
+	if diffStat := getDiffStatText(linesAdded, linesDeleted); diffStat != "" {
+		output += " " + diffStat
+	}
+
 	return output
 }
 
+// getDiffStatText returns something like '+12 -3 ■■', or nothing if there are no
+// changed lines. The bar gets one block longer with each order of magnitude of
+// changed lines, so that big changes stand out, and is split between additions
+// and deletions in proportion
+func getDiffStatText(linesAdded int, linesDeleted int) string {
+	total := linesAdded + linesDeleted
+	if total == 0 {
+		return ""
+	}
+
+	blocks := utils.Min(len(strconv.Itoa(total)), DIFF_STAT_BAR_WIDTH)
+	addedBlocks := int(math.Round(float64(blocks*linesAdded) / float64(total)))
+
+	return fmt.Sprintf(
+		"%s %s %s%s",
+		style.FgGreen.Sprintf("+%d", linesAdded),
+		style.FgRed.Sprintf("-%d", linesDeleted),
+		style.FgGreen.Sprint(strings.Repeat(DIFF_STAT_BLOCK, addedBlocks)),
+		style.FgRed.Sprint(strings.Repeat(DIFF_STAT_BLOCK, blocks-addedBlocks)),
+	)
+}
+
 func getColorForChangeStatus(changeStatus string) style.TextStyle {
 	switch changeStatus {
 	case "A":
@@ -41,6 +41,29 @@ func TestRenderFileTree(t *testing.T) {
 			},
 			expected: []string{" M test"},
 		},
+		{
+			name: "resolved by rerere",
+			files: []*models.File{
+				{Name: "test", ShortStatus: "UU", HasUnstagedChanges: true, HasMergeConflicts: true, HasInlineMergeConflicts: true, ResolvedByRerere: true},
+			},
+			expected: []string{"UU test (resolved by rerere)"},
+		},
+		{
+			name: "diff stats",
+			files: []*models.File{
+				{Name: "dir/file1", ShortStatus: "M ", HasUnstagedChanges: true, LinesAdded: 5, LinesDeleted: 7},
+				{Name: "dir/file2", ShortStatus: "M ", HasUnstagedChanges: true, LinesAdded: 95},
+				{Name: "file3", ShortStatus: "??", HasUnstagedChanges: true},
+			},
+			expected: toStringSlice(
+				`
+▼ dir +100 -7 ■■■
+  M  file1 +5 -7 ■■
+  M  file2 +95 -0 ■■
+?? file3
+`,
+			),
+		},
 		{
 			name: "big example",
 			files: []*models.File{
@@ -100,6 +123,22 @@ func TestRenderCommitFileTree(t *testing.T) {
 			},
 			expected: []string{"A test"},
 		},
+		{
+			name: "diff stats",
+			files: []*models.CommitFile{
+				{Name: "dir/file1", ChangeStatus: "M", LinesAdded: 1, LinesDeleted: 1},
+				{Name: "dir/file2", ChangeStatus: "D", LinesDeleted: 1200},
+				{Name: "image.png", ChangeStatus: "A"},
+			},
+			expected: toStringSlice(
+				`
+▼ dir +1 -1201 ■■■■
+  M file1 +1 -1 ■
+  D file2 +0 -1200 ■■■■
+A image.png
+`,
+			),
+		},
 		{
 			name: "big example",
 			files: []*models.CommitFile{
@@ -1,17 +1,55 @@
 package presentation
 
 import (
-	"github.com/jesseduffield/generics/slices"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
-func GetSubmoduleListDisplayStrings(submodules []*models.SubmoduleConfig) [][]string {
-	return slices.Map(submodules, func(submodule *models.SubmoduleConfig) []string {
-		return getSubmoduleDisplayStrings(submodule)
-	})
+func GetSubmoduleListDisplayStrings(submodules []*models.SubmoduleConfig, tr *i18n.TranslationSet) [][]string {
+	// nested submodules come straight after their parent so we'll always have seen
+	// the parent by the time we get to them
+	depths := map[string]int{}
+
+	result := make([][]string, 0, len(submodules))
+	for _, submodule := range submodules {
+		depth := 0
+		if submodule.IsNested() {
+			depth = depths[submodule.ParentPath] + 1
+		}
+		depths[submodule.Path] = depth
+
+		result = append(result, getSubmoduleDisplayStrings(submodule, depth, tr))
+	}
+
+	return result
 }
 
-func getSubmoduleDisplayStrings(s *models.SubmoduleConfig) []string {
-	return []string{theme.DefaultTextColor.Sprint(s.Name)}
+func getSubmoduleDisplayStrings(s *models.SubmoduleConfig, depth int, tr *i18n.TranslationSet) []string {
+	name := strings.Repeat("  ", depth) + theme.DefaultTextColor.Sprint(s.Name)
+
+	if !s.Initialized {
+		return []string{name, "", style.FgMagenta.Sprint(tr.LcSubmoduleNotInitialised)}
+	}
+
+	branch := style.FgCyan.Sprint(s.Branch)
+	if s.Branch == "" {
+		branch = style.FgMagenta.Sprint(tr.LcDetachedHead)
+	}
+
+	status := ""
+	if s.IsOnRecordedCommit() {
+		status = style.FgGreen.Sprint(utils.ShortSha(s.Head))
+	} else {
+		status = style.FgYellow.Sprintf("%s (%s %s)", utils.ShortSha(s.Head), tr.LcRecorded, utils.ShortSha(s.RecordedSha))
+	}
+	if s.Dirty {
+		status += " " + style.FgRed.Sprint(tr.LcSubmoduleModified)
+	}
+
+	return []string{name, branch, status}
 }
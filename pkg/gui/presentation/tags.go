@@ -3,27 +3,51 @@ package presentation
 import (
 	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
-func GetTagListDisplayStrings(tags []*models.Tag, diffName string) [][]string {
+func GetTagListDisplayStrings(tags []*models.Tag, fullDescription bool, diffName string, timeFormat string) [][]string {
 	return slices.Map(tags, func(tag *models.Tag) []string {
 		diffed := tag.Name == diffName
-		return getTagDisplayStrings(tag, diffed)
+		return getTagDisplayStrings(tag, fullDescription, diffed, timeFormat)
 	})
 }
 
 // getTagDisplayStrings returns the display string of branch
-func getTagDisplayStrings(t *models.Tag, diffed bool) []string {
+func getTagDisplayStrings(t *models.Tag, fullDescription bool, diffed bool, timeFormat string) []string {
 	textStyle := theme.DefaultTextColor
 	if diffed {
 		textStyle = theme.DiffTerminalColor
 	}
-	res := make([]string, 0, 2)
+	res := make([]string, 0, 6)
 	if icons.IsIconEnabled() {
 		res = append(res, textStyle.Sprint(icons.IconForTag(t)))
 	}
 	res = append(res, textStyle.Sprint(t.Name))
+
+	if fullDescription {
+		tagger := ""
+		if t.IsAnnotated {
+			tagger = authors.LongAuthor(t.Tagger)
+		}
+		res = append(res,
+			style.FgBlue.Sprint(t.ShortTargetSha()),
+			style.FgMagenta.Sprint(utils.UnixToDate(t.UnixTimestamp, timeFormat)),
+			tagger,
+		)
+	}
+
+	// a lightweight tag has no message of its own so we show the commit's subject
+	// in a different colour to make that clear
+	subjectStyle := theme.DefaultTextColor
+	if t.IsAnnotated {
+		subjectStyle = style.FgYellow
+	}
+	res = append(res, subjectStyle.Sprint(t.Subject))
+
 	return res
 }
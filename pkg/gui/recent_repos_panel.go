@@ -120,6 +120,9 @@ func (gui *Gui) handleShowAllBranchLogs() error {
 }
 
 func (gui *Gui) dispatchSwitchToRepo(path string, reuse bool) error {
+	// the git dir may be a relative path so we need to save before changing directory
+	gui.saveCustomPatch()
+
 	env.UnsetGitDirEnvs()
 	originalPath, err := os.Getwd()
 	if err != nil {
@@ -269,7 +269,7 @@ func (gui *Gui) refreshRebaseCommits() error {
 }
 
 func (self *Gui) refreshTags() error {
-	tags, err := self.git.Loaders.Tags.GetTags()
+	tags, err := self.git.Loaders.Tags.GetTags(self.helpers.Tags.GetSortOrder())
 	if err != nil {
 		return self.c.Error(err)
 	}
@@ -285,6 +285,11 @@ func (gui *Gui) refreshStateSubmoduleConfigs() error {
 		return err
 	}
 
+	if err := gui.git.Submodule.LoadStatuses(configs); err != nil {
+		// we can still show the submodules without their statuses
+		gui.c.Log.Error(err)
+	}
+
 	gui.State.Model.Submodules = configs
 
 	return nil
@@ -389,11 +394,14 @@ func (gui *Gui) refreshStateFiles() error {
 		if file.HasMergeConflicts {
 			prevConflictFileCount++
 		}
-		if file.HasInlineMergeConflicts {
+		// rerere leaves the files it resolves unmerged so that the user can review them
+		if file.HasInlineMergeConflicts && !file.ResolvedByRerere {
 			hasConflicts, err := mergeconflicts.FileHasConflictMarkers(file.Name)
 			if err != nil {
 				gui.Log.Error(err)
-			} else if !hasConflicts {
+			} else if !hasConflicts && !mergeconflicts.FileIsBinary(file.Name) {
+				// binary files never have conflict markers so we leave it to the
+				// user to pick a side
 				pathsToStage = append(pathsToStage, file.Name)
 			}
 		}
@@ -407,7 +415,9 @@ func (gui *Gui) refreshStateFiles() error {
 	}
 
 	files := gui.git.Loaders.Files.
-		GetStatusFiles(loaders.GetStatusFileOptions{})
+		GetStatusFiles(loaders.GetStatusFileOptions{DiffStats: gui.c.UserConfig.Gui.ShowFileDiffStats})
+
+	gui.markFilesResolvedByRerere(files)
 
 	conflictFileCount := 0
 	for _, file := range files {
@@ -446,6 +456,34 @@ func (gui *Gui) refreshStateFiles() error {
 	return nil
 }
 
+// markFilesResolvedByRerere flags the conflicted files that no longer have any
+// conflict markers because rerere resolved them. If the user resolved them
+// themselves, rerere still lists them as remaining until the resolution is recorded.
+func (gui *Gui) markFilesResolvedByRerere(files []*models.File) {
+	candidates := slices.Filter(files, func(file *models.File) bool {
+		if !file.HasInlineMergeConflicts || mergeconflicts.FileIsBinary(file.Name) {
+			return false
+		}
+
+		hasConflicts, err := mergeconflicts.FileHasConflictMarkers(file.Name)
+		return err == nil && !hasConflicts
+	})
+
+	if len(candidates) == 0 || !gui.git.Rerere.IsEnabled() {
+		return
+	}
+
+	remaining, err := gui.git.Rerere.Remaining()
+	if err != nil {
+		gui.Log.Error(err)
+		return
+	}
+
+	for _, file := range candidates {
+		file.ResolvedByRerere = !slices.Contains(remaining, file.Name)
+	}
+}
+
 // the reflogs panel is the only panel where we cache data, in that we only
 // load entries that have been created since we last ran the call. This means
 // we need to be more careful with how we use this, and to ensure we're emptying
@@ -709,5 +747,6 @@ func (gui *Gui) refreshMergePanel(isFocused bool) error {
 		Main: &types.ViewUpdateOpts{
 			Task: task,
 		},
+		Secondary: gui.helpers.MergeConflicts.ThreeWayViewUpdateOpts(),
 	})
 }
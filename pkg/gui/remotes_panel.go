@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 )
 
 // list panel functions
@@ -16,7 +18,7 @@ func (gui *Gui) remotesRenderToMain() error {
 	if remote == nil {
 		task = types.NewRenderStringTask("No remotes")
 	} else {
-		task = types.NewRenderStringTask(fmt.Sprintf("%s\nUrls:\n%s", style.FgGreen.Sprint(remote.Name), strings.Join(remote.Urls, "\n")))
+		task = types.NewRenderStringTask(remoteDetails(remote, gui.c.Tr))
 	}
 
 	return gui.c.RenderToMainViews(types.RefreshMainOpts{
@@ -27,3 +29,26 @@ func (gui *Gui) remotesRenderToMain() error {
 		},
 	})
 }
+
+func remoteDetails(remote *models.Remote, tr *i18n.TranslationSet) string {
+	section := func(title string, values []string, fallback string) string {
+		if len(values) == 0 {
+			return fmt.Sprintf("%s:\n%s", style.FgYellow.Sprint(title), fallback)
+		}
+		return fmt.Sprintf("%s:\n%s", style.FgYellow.Sprint(title), strings.Join(values, "\n"))
+	}
+
+	headBranch := remote.HeadBranch
+	if headBranch == "" {
+		headBranch = tr.RemoteUnknownHeadBranch
+	}
+
+	return strings.Join([]string{
+		style.FgGreen.Sprint(remote.Name),
+		section(tr.RemoteUrls, remote.Urls, ""),
+		section(tr.RemotePushUrls, remote.PushUrls, tr.RemotePushUrlsFallback),
+		section(tr.RemoteFetchRefspecs, remote.FetchRefspecs, tr.RemoteNoRefspecs),
+		section(tr.RemotePushRefspecs, remote.PushRefspecs, tr.RemoteNoRefspecs),
+		fmt.Sprintf("%s: %s", style.FgYellow.Sprint(tr.RemoteHeadBranch), headBranch),
+	}, "\n\n")
+}
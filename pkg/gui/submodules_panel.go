@@ -16,17 +16,27 @@ func (gui *Gui) submodulesRenderToMain() error {
 		task = types.NewRenderStringTask("No submodules")
 	} else {
 		prefix := fmt.Sprintf(
-			"Name: %s\nPath: %s\nUrl:  %s\n\n",
+			"Name: %s\nPath: %s\nUrl:  %s\n",
 			style.FgGreen.Sprint(submodule.Name),
 			style.FgYellow.Sprint(submodule.Path),
 			style.FgCyan.Sprint(submodule.Url),
 		)
 
-		file := gui.helpers.WorkingTree.FileForSubmodule(submodule)
-		if file == nil {
+		if !submodule.Initialized {
 			task = types.NewRenderStringTask(prefix)
 		} else {
-			cmdObj := gui.git.WorkingTree.WorktreeFileDiffCmdObj(file, false, !file.HasUnstagedChanges && file.HasStagedChanges, gui.IgnoreWhitespaceInDiffView)
+			branch := submodule.Branch
+			if branch == "" {
+				branch = gui.c.Tr.LcDetachedHead
+			}
+			prefix += fmt.Sprintf(
+				"Branch:   %s\nHead:     %s\nRecorded: %s\n\n",
+				style.FgCyan.Sprint(branch),
+				style.FgYellow.Sprint(submodule.Head),
+				style.FgYellow.Sprint(submodule.RecordedSha),
+			)
+
+			cmdObj := gui.git.Submodule.DiffCmdObj(submodule)
 			task = types.NewRunCommandTaskWithPrefix(cmdObj.GetCmd(), prefix)
 		}
 	}
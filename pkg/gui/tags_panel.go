@@ -4,12 +4,16 @@ import "github.com/jesseduffield/lazygit/pkg/gui/types"
 
 func (gui *Gui) tagsRenderToMain() error {
 	var task types.UpdateTask
+	var secondary *types.ViewUpdateOpts
 	tag := gui.State.Contexts.Tags.GetSelected()
 	if tag == nil {
 		task = types.NewRenderStringTask("No tags")
 	} else {
-		cmdObj := gui.git.Branch.GetGraphCmdObj(tag.FullRefName())
+		cmdObj := gui.git.Tag.ShowCmdObj(tag.Name)
 		task = types.NewRunCommandTask(cmdObj.GetCmd())
+		if gui.c.UserConfig.Git.Log.ShowSignatures {
+			secondary = gui.signaturePanelUpdateOpts(gui.git.Tag.VerifyCmdObj(tag.Name))
+		}
 	}
 
 	return gui.c.RenderToMainViews(types.RefreshMainOpts{
@@ -18,5 +22,6 @@ func (gui *Gui) tagsRenderToMain() error {
 			Title: "Tag",
 			Task:  task,
 		},
+		Secondary: secondary,
 	})
 }
@@ -6,10 +6,36 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
 )
 
 func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+	return gui.newCmdTaskAux(view, cmd, prefix, nil)
+}
+
+// newDiffCmdTask is for a command that outputs a diff which we're not passing
+// through a pager, so that we can emphasise the changed words, lay out the diff
+// side by side or highlight its syntax ourselves
+func (gui *Gui) newDiffCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+	wordDiff := gui.c.UserConfig.Git.WordDiff
+	sideBySide := gui.c.UserConfig.Git.SideBySideDiff
+	syntaxHighlighting := gui.c.UserConfig.Git.SyntaxHighlighting
+	if wordDiff != patch.WORD_DIFF_WORD && wordDiff != patch.WORD_DIFF_CHAR && !sideBySide && !syntaxHighlighting {
+		return gui.newCmdTask(view, cmd, prefix)
+	}
+
+	sideBySideWidth := 0
+	if sideBySide {
+		sideBySideWidth, _ = view.Size()
+	}
+
+	return gui.newCmdTaskAux(view, cmd, prefix, func(r io.Reader) io.Reader {
+		return patch.NewDiffReader(r, wordDiff, sideBySideWidth, syntaxHighlighting)
+	})
+}
+
+func (gui *Gui) newCmdTaskAux(view *gocui.View, cmd *exec.Cmd, prefix string, wrapOutput func(io.Reader) io.Reader) error {
 	cmdStr := strings.Join(cmd.Args, " ")
 	gui.c.Log.WithField(
 		"command",
@@ -32,6 +58,10 @@ func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 			gui.c.Log.Error(err)
 		}
 
+		if wrapOutput != nil {
+			return cmd, wrapOutput(r)
+		}
+
 		return cmd, r
 	}
 
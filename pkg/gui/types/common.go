@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -160,6 +161,10 @@ type Model struct {
 
 	// for displaying suggestions while typing in a file name
 	FilesTrie *patricia.Trie
+
+	// local branches whose upstreams got new commits in a background fetch. These
+	// are highlighted in the branches panel until the panel loses focus
+	BranchesWithNewCommits *set.Set[string]
 }
 
 // if you add a new mutex here be sure to instantiate it. We're using pointers to
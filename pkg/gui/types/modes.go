@@ -4,10 +4,12 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/splitting"
 )
 
 type Modes struct {
 	Filtering     filtering.Filtering
 	CherryPicking *cherrypicking.CherryPicking
 	Diffing       diffing.Diffing
+	Splitting     *splitting.Splitting
 }
@@ -53,6 +53,16 @@ type TranslationSet struct {
 	FilterStagedFiles                   string
 	FilterUnstagedFiles                 string
 	ResetCommitFilterState              string
+	FilterFilesByPath                   string
+	FilterFilesByPathPrompt             string
+	FilterFilesByChangeType             string
+	ChangeTypeAdded                     string
+	ChangeTypeModified                  string
+	ChangeTypeDeleted                   string
+	ChangeTypeRenamed                   string
+	ChangeTypeUntracked                 string
+	ChangeTypeConflicted                string
+	LcFilteringFiles                    string
 	MergeConflictsTitle                 string
 	LcCheckout                          string
 	NoChangedFiles                      string
@@ -99,6 +109,13 @@ type TranslationSet struct {
 	LcMoveDownCommit                    string
 	LcMoveUpCommit                      string
 	LcEditCommit                        string
+	LcSplitCommit                       string
+	SplitCommitTitle                    string
+	SplitCommitPrompt                   string
+	SplitCommitNextPart                 string
+	LcSplittingCommit                   string
+	CannotSplitMergeCommit              string
+	CannotSplitTodoCommit               string
 	LcAmendToCommit                     string
 	LcResetCommitAuthor                 string
 	SetAuthorPromptTitle                string
@@ -168,6 +185,8 @@ type TranslationSet struct {
 	LcFetch                             string
 	NoAutomaticGitFetchTitle            string
 	NoAutomaticGitFetchBody             string
+	NewCommitsFetched                   string
+	NewCommitsFetchedMarker             string
 	FileEnter                           string
 	FileStagingRequirements             string
 	StageSelection                      string
@@ -176,11 +195,15 @@ type TranslationSet struct {
 	ToggleSelectHunk                    string
 	ToggleSelectionForPatch             string
 	EditHunk                            string
+	EditHunkAndApplyToIndex             string
+	EditHunkInstructions                string
+	EditedHunkDoesNotApply              string
 	ToggleStagingPanel                  string
 	ReturnToFilesPanel                  string
 	FastForward                         string
 	Fetching                            string
 	FoundConflicts                      string
+	SquashMergeConflicts                string
 	FoundConflictsTitle                 string
 	PickHunk                            string
 	PickAllHunks                        string
@@ -204,6 +227,49 @@ type TranslationSet struct {
 	RebasingTitle                       string
 	ConfirmRebase                       string
 	ConfirmMerge                        string
+	MergeMenuTitle                      string
+	LcRegularMerge                      string
+	LcMergeNoFastForward                string
+	LcMergeFastForwardOnly              string
+	LcSquashMerge                       string
+	LcMergeWithCustomMessage            string
+	MergeMessageTitle                   string
+	LcMergeStrategy                     string
+	LcMergeStrategyOptions              string
+	MergeStrategyTitle                  string
+	MergeStrategyOptionsTitle           string
+	LcDefault                           string
+	ThreeWayMergeTitle                  string
+	LcToggleThreeWayMerge               string
+	LcPickOurs                          string
+	LcPickBase                          string
+	LcPickTheirs                        string
+	LcEditMergeResultLine               string
+	LcDeleteMergeResultLine             string
+	EditMergeResultLineTitle            string
+	ConflictModifiedByBoth              string
+	ConflictAddedByBoth                 string
+	ConflictDeletedByUs                 string
+	ConflictDeletedByThem               string
+	ConflictDeletedByBoth               string
+	ConflictAddedByUs                   string
+	ConflictAddedByThem                 string
+	WholeFileConflictHint               string
+	ResolveConflictMenuTitle            string
+	LcResolveConflict                   string
+	LcKeepOurs                          string
+	LcKeepTheirs                        string
+	LcKeepTheirModifiedVersion          string
+	LcKeepOurModifiedVersion            string
+	LcKeepDeleted                       string
+	LcKeepFile                          string
+	LcDeleteFile                        string
+	LcKeepRenamedPath                   string
+	LcStageRerereResolution             string
+	LcForgetRerereResolution            string
+	LcApplyRerereResolution             string
+	LcEnableRerere                      string
+	LcDisableRerere                     string
 	FwdNoUpstream                       string
 	FwdNoLocalUpstream                  string
 	FwdCommitsToPush                    string
@@ -211,6 +277,13 @@ type TranslationSet struct {
 	NoRoom                              string
 	YouAreHere                          string
 	LcRewordNotSupported                string
+	CannotChangeTodoAction              string
+	LcInsertExecTodo                    string
+	InsertExecTodoTooltip               string
+	LcInsertBreakTodo                   string
+	InsertBreakTodoTooltip              string
+	ExecTodoPromptTitle                 string
+	CannotInsertTodoAfterAppliedCommit  string
 	LcCherryPickCopy                    string
 	LcCherryPickCopyRange               string
 	LcPasteCommits                      string
@@ -301,12 +374,20 @@ type TranslationSet struct {
 	DiscardPatch                        string
 	DiscardPatchConfirm                 string
 	CantPatchWhileRebasingError         string
+	CannotMovePatchIntoSourceCommit     string
+	PatchBaseNotInCommits               string
+	CannotMoveMultiCommitPatchForward   string
 	LcToggleAddToPatch                  string
 	LcToggleAllInPatch                  string
 	LcUpdatingPatch                     string
 	ViewPatchOptions                    string
 	PatchOptionsTitle                   string
 	NoPatchError                        string
+	LcExportPatchToFile                 string
+	LcImportPatchFromFile               string
+	ExportPatchTitle                    string
+	ImportPatchTitle                    string
+	PatchExported                       string
 	LcEnterFile                         string
 	ExitCustomPatchBuilder              string
 	EnterUpstream                       string
@@ -338,14 +419,79 @@ type TranslationSet struct {
 	DeleteTagPrompt                     string
 	PushTagTitle                        string
 	LcPushTag                           string
+	LcDeleteRemoteTag                   string
+	DeleteRemoteTagTitle                string
+	DeleteRemoteTagPrompt               string
+	LcSortOrder                         string
+	TagSortOrderTitle                   string
+	BranchSortOrderTitle                string
+	LcToggleGroupBranchesByPrefix       string
+	LcCleanUpBranches                   string
+	LcBaseBranchOptions                 string
+	BaseBranchMenuTitle                 string
+	LcSetAsBaseBranch                   string
+	LcEnterBaseBranch                   string
+	LcResetBaseBranch                   string
+	LcToggleShowBaseBranchDivergence    string
+	BaseBranchPromptTitle               string
+	CleanUpBranchesTitle                string
+	CleanUpBranchesPrompt               string
+	ForceCleanUpBranchesPrompt          string
+	NoBranchesToCleanUp                 string
+	NoBranchesSelected                  string
+	LcDeleteSelectedBranches            string
+	MergedIntoBranch                    string
+	LcViewPushOptions                   string
+	PushOptionsTitle                    string
+	LcPushToRefspec                     string
+	LcAtomicPush                        string
+	LcPushWithPushOptions               string
+	LcPushWithoutHooks                  string
+	LcPushTagsOnly                      string
+	LcForcePushWithLeaseOnLastFetch     string
+	PushRemotePromptTitle               string
+	PushRefspecPromptTitle              string
+	PushOptionsPromptTitle              string
+	ForcePushWithLeaseNoUpstream        string
+	ForcePushWithLeasePrompt            string
+	LcViewPullOptions                   string
+	PullOptionsTitle                    string
+	LcPullWithRebase                    string
+	LcPullWithMerge                     string
+	LcPullFastForwardOnly               string
+	LcPullAutoStash                     string
+	LastUsedSuffix                      string
 	LcCreateTag                         string
 	CreateTagTitle                      string
 	LcFetchRemote                       string
 	FetchingRemoteStatus                string
+	LcFetchAllRemotes                   string
+	FetchingAllRemotesStatus            string
+	LcEditRemotePushUrl                 string
+	EditRemotePushUrlTitle              string
+	LcSetRemoteHeadAutomatically        string
+	SettingRemoteHeadStatus             string
+	RemoteUrls                          string
+	RemotePushUrls                      string
+	RemoteFetchRefspecs                 string
+	RemotePushRefspecs                  string
+	RemoteHeadBranch                    string
+	RemotePushUrlsFallback              string
+	RemoteUnknownHeadBranch             string
+	RemoteNoRefspecs                    string
 	LcCheckoutCommit                    string
 	SureCheckoutThisCommit              string
 	LcGitFlowOptions                    string
 	NotAGitFlowBranch                   string
+	NoBranchTypesConfigured             string
+	NoMergeTargetsForBranchType         string
+	BranchingModelTitle                 string
+	LcFinishBranch                      string
+	LcStartBranchOfType                 string
+	FinishBranchTitle                   string
+	FinishBranchPrompt                  string
+	FinishBranchTagNote                 string
+	FinishBranchDeleteNote              string
 	NewBranchNamePrompt                 string
 	IgnoreTracked                       string
 	ExcludeTracked                      string
@@ -440,6 +586,12 @@ type TranslationSet struct {
 	LcUpdatingSubmoduleStatus           string
 	LcBulkInitSubmodules                string
 	LcBulkUpdateSubmodules              string
+	LcBulkUpdateSubmodulesRecursively   string
+	LcBulkSyncSubmodulesRecursively     string
+	LcSubmoduleNotInitialised           string
+	LcDetachedHead                      string
+	LcRecorded                          string
+	LcSubmoduleModified                 string
 	LcBulkDeinitSubmodules              string
 	LcViewBulkSubmoduleOptions          string
 	LcBulkSubmoduleOptions              string
@@ -470,6 +622,12 @@ type TranslationSet struct {
 	SelectParentCommitForMerge          string
 	ToggleWhitespaceInDiffView          string
 	IgnoringWhitespaceInDiffView        string
+	ToggleWordDiff                      string
+	ToggleSideBySideDiff                string
+	ToggleSyntaxHighlighting            string
+	WordDiffOff                         string
+	WordDiffWord                        string
+	WordDiffChar                        string
 	ShowingWhitespaceInDiffView         string
 	IncreaseContextInDiffView           string
 	DecreaseContextInDiffView           string
@@ -506,6 +664,7 @@ type TranslationSet struct {
 	EmptyOutput                         string
 	Patch                               string
 	CustomPatch                         string
+	Signature                           string
 	Actions                             Actions
 	Bisect                              Bisect
 }
@@ -532,6 +691,12 @@ type Actions struct {
 	ForceCheckoutBranch               string
 	DeleteBranch                      string
 	Merge                             string
+	SquashMerge                       string
+	ForgetRerereResolution            string
+	ApplyRerereResolution             string
+	EnableRerere                      string
+	DisableRerere                     string
+	ResolveConflict                   string
 	RebaseBranch                      string
 	RenameBranch                      string
 	SetUnsetUpstream                  string
@@ -545,6 +710,8 @@ type Actions struct {
 	RewordCommit                      string
 	DropCommit                        string
 	EditCommit                        string
+	SplitCommit                       string
+	InsertRebaseTodo                  string
 	AmendCommit                       string
 	ResetCommitAuthor                 string
 	SetCommitAuthor                   string
@@ -576,6 +743,7 @@ type Actions struct {
 	ExcludeGitIgnoreErr               string
 	Commit                            string
 	EditFile                          string
+	EditHunk                          string
 	Push                              string
 	Pull                              string
 	OpenFile                          string
@@ -596,7 +764,12 @@ type Actions struct {
 	AddRemote                         string
 	RemoveRemote                      string
 	UpdateRemote                      string
+	UpdateRemotePushUrl               string
+	FetchAllRemotes                   string
+	SetRemoteHead                     string
 	ApplyPatch                        string
+	ExportPatch                       string
+	ImportPatch                       string
 	Stash                             string
 	RemoveSubmodule                   string
 	ResetSubmodule                    string
@@ -605,12 +778,17 @@ type Actions struct {
 	InitialiseSubmodule               string
 	BulkInitialiseSubmodules          string
 	BulkUpdateSubmodules              string
+	BulkUpdateSubmodulesRecursively   string
+	BulkSyncSubmodulesRecursively     string
 	BulkDeinitialiseSubmodules        string
 	UpdateSubmodule                   string
 	CreateLightweightTag              string
 	CreateAnnotatedTag                string
 	DeleteTag                         string
 	PushTag                           string
+	DeleteRemoteTag                   string
+	CleanUpBranches                   string
+	SetBaseBranch                     string
 	NukeWorkingTree                   string
 	DiscardUnstagedFileChanges        string
 	RemoveUntrackedFiles              string
@@ -689,10 +867,20 @@ func EnglishTranslationSet() TranslationSet {
 		LcScroll:                            "scroll",
 		MergeConflictsTitle:                 "Merge Conflicts",
 		LcCheckout:                          "checkout",
-		LcFileFilter:                        "Filter files (staged/unstaged)",
+		LcFileFilter:                        "Filter files (status/path/change type)",
 		FilterStagedFiles:                   "Show only staged files",
 		FilterUnstagedFiles:                 "Show only unstaged files",
 		ResetCommitFilterState:              "Reset filter",
+		FilterFilesByPath:                   "Filter by path",
+		FilterFilesByPathPrompt:             "Filter by path (glob or fuzzy text, empty to clear):",
+		FilterFilesByChangeType:             "Filter by change type",
+		ChangeTypeAdded:                     "Added",
+		ChangeTypeModified:                  "Modified",
+		ChangeTypeDeleted:                   "Deleted",
+		ChangeTypeRenamed:                   "Renamed",
+		ChangeTypeUntracked:                 "Untracked",
+		ChangeTypeConflicted:                "Conflicted",
+		LcFilteringFiles:                    "filtering files",
 		NoChangedFiles:                      "No changed files",
 		NoFilesDisplay:                      "No file to display",
 		NotAFile:                            "Not a file",
@@ -738,6 +926,13 @@ func EnglishTranslationSet() TranslationSet {
 		LcMoveDownCommit:                    "move commit down one",
 		LcMoveUpCommit:                      "move commit up one",
 		LcEditCommit:                        "edit commit",
+		LcSplitCommit:                       "split commit",
+		SplitCommitTitle:                    "Split commit",
+		SplitCommitPrompt:                   "This will start a rebase which stops at the selected commit and undoes it, leaving its changes staged. Each time you commit, the staged changes become a new commit. Once nothing is left to commit, the rebase will continue automatically. Continue?",
+		SplitCommitNextPart:                 "Commit created. Stage the next part and commit again",
+		LcSplittingCommit:                   "splitting commit",
+		CannotSplitMergeCommit:              "Splitting merge commits is not supported",
+		CannotSplitTodoCommit:               "Cannot split a commit which has not been rebased yet",
 		LcAmendToCommit:                     "amend commit with staged changes",
 		LcResetCommitAuthor:                 "reset commit author",
 		SetAuthorPromptTitle:                "Set author (must look like 'Name <Email>')",
@@ -806,6 +1001,8 @@ func EnglishTranslationSet() TranslationSet {
 		LcFetch:                             `fetch`,
 		NoAutomaticGitFetchTitle:            `No automatic git fetch`,
 		NoAutomaticGitFetchBody:             `Lazygit can't use "git fetch" in a private repo; use 'f' in the files panel to run "git fetch" manually`,
+		NewCommitsFetched:                   "New commits fetched from {{.remoteName}}: {{.branches}}",
+		NewCommitsFetchedMarker:             "(new)",
 		FileEnter:                           `stage individual hunks/lines for file, or collapse/expand for directory`,
 		FileStagingRequirements:             `Can only stage individual lines for tracked files`,
 		StageSelection:                      `toggle line staged / unstaged`,
@@ -814,11 +1011,15 @@ func EnglishTranslationSet() TranslationSet {
 		ToggleSelectHunk:                    `toggle select hunk`,
 		ToggleSelectionForPatch:             `add/remove line(s) to patch`,
 		EditHunk:                            `edit hunk`,
+		EditHunkAndApplyToIndex:             "edit hunk and apply it to the index",
+		EditHunkInstructions:                "# ---\n# To remove '-' lines, make them ' ' lines (context).\n# To remove '+' lines, delete them.\n# Lines starting with # will be removed.\n#\n# The edited hunk will be applied to the index. If it no longer applies,\n# nothing will be changed.\n",
+		EditedHunkDoesNotApply:              "The edited hunk doesn't apply to the index anymore, so nothing was changed:\n\n{{.error}}",
 		ToggleStagingPanel:                  `switch to other panel (staged/unstaged changes)`,
 		ReturnToFilesPanel:                  `return to files panel`,
 		FastForward:                         `fast-forward this branch from its upstream`,
 		Fetching:                            "fetching and fast-forwarding {{.from}} -> {{.to}} ...",
 		FoundConflicts:                      "Conflicts! To abort press 'esc', otherwise press 'enter'",
+		SquashMergeConflicts:                "Conflicts! Press 'enter' to resolve them. A squash merge can't be aborted: to undo it, run 'git reset --merge'",
 		FoundConflictsTitle:                 "Auto-merge failed",
 		PickHunk:                            "pick hunk",
 		PickAllHunks:                        "pick all hunks",
@@ -843,6 +1044,49 @@ func EnglishTranslationSet() TranslationSet {
 		RebasingTitle:                       "Rebasing",
 		ConfirmRebase:                       "Are you sure you want to rebase '{{.checkedOutBranch}}' onto '{{.selectedBranch}}'?",
 		ConfirmMerge:                        "Are you sure you want to merge '{{.selectedBranch}}' into '{{.checkedOutBranch}}'?",
+		MergeMenuTitle:                      "Merge '{{.selectedBranch}}' into '{{.checkedOutBranch}}'",
+		LcRegularMerge:                      "merge",
+		LcMergeNoFastForward:                "merge, always creating a merge commit",
+		LcMergeFastForwardOnly:              "merge only if it can be fast-forwarded",
+		LcSquashMerge:                       "squash merge, then edit the commit message",
+		LcMergeWithCustomMessage:            "merge with custom message",
+		MergeMessageTitle:                   "Merge commit message:",
+		LcMergeStrategy:                     "strategy",
+		LcMergeStrategyOptions:              "strategy options (-X)",
+		MergeStrategyTitle:                  "Merge strategy",
+		MergeStrategyOptionsTitle:           "Strategy options, separated by spaces (e.g. 'theirs ignore-space-change'):",
+		LcDefault:                           "default",
+		ThreeWayMergeTitle:                  "Three-way merge",
+		LcToggleThreeWayMerge:               "toggle three-way view (ours, base and theirs next to the file)",
+		LcPickOurs:                          "pick ours",
+		LcPickBase:                          "pick base",
+		LcPickTheirs:                        "pick theirs",
+		LcEditMergeResultLine:               "edit selected line (three-way view)",
+		LcDeleteMergeResultLine:             "delete selected line (three-way view)",
+		EditMergeResultLineTitle:            "Edit line:",
+		ConflictModifiedByBoth:              "Modified by both: both sides changed this file but the changes couldn't be merged line by line, e.g. because it's a binary file.",
+		ConflictAddedByBoth:                 "Added by both: both sides added a file at this path but their contents couldn't be merged line by line, e.g. because it's a binary file.",
+		ConflictDeletedByUs:                 "Deleted by us: we deleted this file but they modified it. You can keep their modified version or keep it deleted.",
+		ConflictDeletedByThem:               "Deleted by them: they deleted this file but we modified it. You can keep our modified version or keep it deleted.",
+		ConflictDeletedByBoth:               "Deleted by both: usually this means the file was renamed to a different path on each side. Its new paths show up as added by us (AU) and added by them (UA), and you can keep one of them.",
+		ConflictAddedByUs:                   "Added by us: only our side has a file at this path, e.g. because we renamed the file and they renamed or deleted it.",
+		ConflictAddedByThem:                 "Added by them: only their side has a file at this path, e.g. because they renamed the file and we renamed or deleted it.",
+		WholeFileConflictHint:               "Press {{.key}} to choose how to resolve this conflict.",
+		ResolveConflictMenuTitle:            "Resolve conflict",
+		LcResolveConflict:                   "resolve conflict for the whole file",
+		LcKeepOurs:                          "keep ours",
+		LcKeepTheirs:                        "keep theirs",
+		LcKeepTheirModifiedVersion:          "keep their modified version",
+		LcKeepOurModifiedVersion:            "keep our modified version",
+		LcKeepDeleted:                       "keep deleted",
+		LcKeepFile:                          "keep file",
+		LcDeleteFile:                        "delete file",
+		LcKeepRenamedPath:                   "keep '{{.path}}' (removes {{.removed}})",
+		LcStageRerereResolution:             "stage the resolution recorded by rerere",
+		LcForgetRerereResolution:            "forget the resolution recorded by rerere and resolve again",
+		LcApplyRerereResolution:             "apply the resolution recorded by rerere",
+		LcEnableRerere:                      "enable rerere (reuse recorded resolutions)",
+		LcDisableRerere:                     "disable rerere (reuse recorded resolutions)",
 		FwdNoUpstream:                       "Cannot fast-forward a branch with no upstream",
 		FwdNoLocalUpstream:                  "Cannot fast-forward a branch whose remote is not registered locally",
 		FwdCommitsToPush:                    "Cannot fast-forward a branch with commits to push",
@@ -850,6 +1094,13 @@ func EnglishTranslationSet() TranslationSet {
 		NoRoom:                              "Not enough room",
 		YouAreHere:                          "YOU ARE HERE",
 		LcRewordNotSupported:                "rewording commits while interactively rebasing is not currently supported",
+		CannotChangeTodoAction:              "'%s' lines can only be moved or removed",
+		LcInsertExecTodo:                    "insert exec after selected commit",
+		InsertExecTodoTooltip:               "Run a shell command after the selected commit has been applied, e.g. to run tests at each step. The rebase stops if the command fails",
+		LcInsertBreakTodo:                   "insert break after selected commit",
+		InsertBreakTodoTooltip:              "Pause the rebase after the selected commit has been applied",
+		ExecTodoPromptTitle:                 "Command to run:",
+		CannotInsertTodoAfterAppliedCommit:  "You can only insert a todo after a commit that is yet to be applied, or the commit you are currently at",
 		LcCherryPickCopy:                    "copy commit (cherry-pick)",
 		LcCherryPickCopyRange:               "copy commit range (cherry-pick)",
 		LcPasteCommits:                      "paste commits (cherry-pick)",
@@ -940,12 +1191,20 @@ func EnglishTranslationSet() TranslationSet {
 		DiscardPatch:                        "Discard Patch",
 		DiscardPatchConfirm:                 "You can only build a patch from one commit/stash-entry at a time. Discard current patch?",
 		CantPatchWhileRebasingError:         "You cannot build a patch or run patch commands while in a merging or rebasing state",
+		CannotMovePatchIntoSourceCommit:     "You cannot move a patch into one of the commits it was built from",
+		PatchBaseNotInCommits:               "The commit that the patch was built against is not in the commits panel",
+		CannotMoveMultiCommitPatchForward:   "A patch built across several commits can only be moved into an older commit",
 		LcToggleAddToPatch:                  "toggle file included in patch",
 		LcToggleAllInPatch:                  "toggle all files included in patch",
 		LcUpdatingPatch:                     "updating patch",
 		ViewPatchOptions:                    "view custom patch options",
 		PatchOptionsTitle:                   "Patch Options",
 		NoPatchError:                        "No patch created yet. To start building a patch, use 'space' on a commit file or enter to add specific lines",
+		LcExportPatchToFile:                 "export patch to file",
+		LcImportPatchFromFile:               "import patch from file",
+		ExportPatchTitle:                    "Export patch to:",
+		ImportPatchTitle:                    "Import patch from:",
+		PatchExported:                       "Patch exported to %s",
 		LcEnterFile:                         "enter file to add selected lines to the patch (or toggle directory collapsed)",
 		ExitCustomPatchBuilder:              `exit custom patch builder`,
 		EnterUpstream:                       `Enter upstream as '<remote> <branchname>'`,
@@ -977,14 +1236,79 @@ func EnglishTranslationSet() TranslationSet {
 		DeleteTagPrompt:                     "Are you sure you want to delete tag '{{.tagName}}'?",
 		PushTagTitle:                        "remote to push tag '{{.tagName}}' to:",
 		LcPushTag:                           "push tag",
+		LcDeleteRemoteTag:                   "delete remote tag",
+		DeleteRemoteTagTitle:                "remote from which to delete tag '{{.tagName}}':",
+		DeleteRemoteTagPrompt:               "Are you sure you want to delete the remote tag '{{.tagName}}' from '{{.remoteName}}'?",
+		LcSortOrder:                         "sort order",
+		TagSortOrderTitle:                   "Tag sort order",
+		BranchSortOrderTitle:                "Branch sort order",
+		LcToggleGroupBranchesByPrefix:       "toggle grouping by prefix (e.g. feature/)",
+		LcCleanUpBranches:                   "clean up merged and gone branches",
+		LcBaseBranchOptions:                 "view base branch options",
+		BaseBranchMenuTitle:                 "Base branch (currently '{{.baseBranch}}')",
+		LcSetAsBaseBranch:                   "set '{{.branchName}}' as base branch for this repo",
+		LcEnterBaseBranch:                   "enter base branch for this repo",
+		LcResetBaseBranch:                   "reset base branch to default",
+		LcToggleShowBaseBranchDivergence:    "toggle showing divergence from base branch",
+		BaseBranchPromptTitle:               "Base branch:",
+		CleanUpBranchesTitle:                "Clean up branches",
+		CleanUpBranchesPrompt:               "Are you sure you want to delete {{.count}} branch(es)?",
+		ForceCleanUpBranchesPrompt:          "The following branch(es) are not fully merged into HEAD: {{.branchNames}}. Are you sure you want to force delete them?",
+		NoBranchesToCleanUp:                 "There are no branches which have been merged into a main branch (see git.mainBranches) or whose upstream is gone",
+		NoBranchesSelected:                  "No branches selected",
+		LcDeleteSelectedBranches:            "delete {{.count}} selected branch(es)",
+		MergedIntoBranch:                    "merged into {{.branchName}}",
+		LcViewPushOptions:                   "view push options",
+		PushOptionsTitle:                    "Push options",
+		LcPushToRefspec:                     "push to refspec (e.g. HEAD:refs/for/main)",
+		LcAtomicPush:                        "atomic push (--atomic)",
+		LcPushWithPushOptions:               "push with push options (-o)",
+		LcPushWithoutHooks:                  "push without running pre-push hook (--no-verify)",
+		LcPushTagsOnly:                      "push tags only (--tags)",
+		LcForcePushWithLeaseOnLastFetch:     "force push if upstream is unchanged since last fetch",
+		PushRemotePromptTitle:               "Remote to push to:",
+		PushRefspecPromptTitle:              "Refspec to push:",
+		PushOptionsPromptTitle:              "Push options (comma-separated):",
+		ForcePushWithLeaseNoUpstream:        "Cannot force push with lease: the checked out branch has no upstream",
+		ForcePushWithLeasePrompt:            "Force push, overwriting {{.upstream}} only if it is still at {{.sha}}?",
+		LcViewPullOptions:                   "view pull options",
+		PullOptionsTitle:                    "Pull options",
+		LcPullWithRebase:                    "pull with rebase (--rebase)",
+		LcPullWithMerge:                     "pull with merge (--no-rebase)",
+		LcPullFastForwardOnly:               "pull fast-forward only (--ff-only)",
+		LcPullAutoStash:                     "stash local changes before pulling (--autostash)",
+		LastUsedSuffix:                      "(last used)",
 		LcCreateTag:                         "create tag",
 		CreateTagTitle:                      "Tag name:",
 		LcFetchRemote:                       "fetch remote",
 		FetchingRemoteStatus:                "fetching remote",
+		LcFetchAllRemotes:                   "fetch all remotes, pruning deleted branches and fetching tags",
+		FetchingAllRemotesStatus:            "fetching all remotes",
+		LcEditRemotePushUrl:                 "edit remote push URL",
+		EditRemotePushUrlTitle:              "Enter push URL for remote '{{.remoteName}}'",
+		LcSetRemoteHeadAutomatically:        "set remote HEAD automatically",
+		SettingRemoteHeadStatus:             "setting remote HEAD",
+		RemoteUrls:                          "Urls",
+		RemotePushUrls:                      "Push urls",
+		RemoteFetchRefspecs:                 "Fetch refspecs",
+		RemotePushRefspecs:                  "Push refspecs",
+		RemoteHeadBranch:                    "HEAD branch",
+		RemotePushUrlsFallback:              "(same as urls)",
+		RemoteUnknownHeadBranch:             "(unknown)",
+		RemoteNoRefspecs:                    "(none)",
 		LcCheckoutCommit:                    "checkout commit",
 		SureCheckoutThisCommit:              "Are you sure you want to checkout this commit?",
 		LcGitFlowOptions:                    "show git-flow options",
 		NotAGitFlowBranch:                   "This does not seem to be a git flow branch",
+		NoBranchTypesConfigured:             "No branch types are configured. See git.branchingModel in the config docs",
+		NoMergeTargetsForBranchType:         "Branch type '%s' has no branches to merge into. See git.branchingModel in the config docs",
+		BranchingModelTitle:                 "Branching model",
+		LcFinishBranch:                      "finish branch '{{.branchName}}'",
+		LcStartBranchOfType:                 "start {{.branchType}}",
+		FinishBranchTitle:                   "Finish branch",
+		FinishBranchPrompt:                  "'{{.branchName}}' will be merged into {{.mergeTargets}}.",
+		FinishBranchTagNote:                 "'{{.mergeTarget}}' will be tagged as '{{.tagName}}'.",
+		FinishBranchDeleteNote:              "The branch will then be deleted.",
 		NewGitFlowBranchPrompt:              "new {{.branchType}} name:",
 		IgnoreTracked:                       "Ignore tracked file",
 		IgnoreTrackedPrompt:                 "Are you sure you want to ignore a tracked file?",
@@ -1080,6 +1404,12 @@ func EnglishTranslationSet() TranslationSet {
 		LcUpdatingSubmoduleStatus:           "updating submodule",
 		LcBulkInitSubmodules:                "bulk init submodules",
 		LcBulkUpdateSubmodules:              "bulk update submodules",
+		LcBulkUpdateSubmodulesRecursively:   "bulk init and update submodules, including nested ones",
+		LcBulkSyncSubmodulesRecursively:     "bulk sync submodule urls, including nested ones",
+		LcSubmoduleNotInitialised:           "not initialised",
+		LcDetachedHead:                      "detached",
+		LcRecorded:                          "recorded",
+		LcSubmoduleModified:                 "modified",
 		LcBulkDeinitSubmodules:              "bulk deinit submodules",
 		LcViewBulkSubmoduleOptions:          "view bulk submodule options",
 		LcBulkSubmoduleOptions:              "bulk submodule options",
@@ -1109,6 +1439,12 @@ func EnglishTranslationSet() TranslationSet {
 		SelectParentCommitForMerge:          "Select parent commit for merge",
 		ToggleWhitespaceInDiffView:          "Toggle whether or not whitespace changes are shown in the diff view",
 		IgnoringWhitespaceInDiffView:        "Whitespace will be ignored in the diff view",
+		ToggleWordDiff:                      "Cycle highlighting of changed words in diffs (off, words, characters)",
+		ToggleSideBySideDiff:                "Toggle showing the old and new versions side by side in diffs",
+		ToggleSyntaxHighlighting:            "Toggle highlighting the syntax of the content of diffs",
+		WordDiffOff:                         "Changed words will not be highlighted in diffs",
+		WordDiffWord:                        "Changed words will be highlighted in diffs",
+		WordDiffChar:                        "Changed characters will be highlighted in diffs",
 		ShowingWhitespaceInDiffView:         "Whitespace will be shown in the diff view",
 		IncreaseContextInDiffView:           "Increase the size of the context shown around changes in the diff view",
 		DecreaseContextInDiffView:           "Decrease the size of the context shown around changes in the diff view",
@@ -1145,6 +1481,7 @@ func EnglishTranslationSet() TranslationSet {
 		EmptyOutput:                         "<empty output>",
 		Patch:                               "Patch",
 		CustomPatch:                         "Custom patch",
+		Signature:                           "Signature",
 		Actions: Actions{
 			// TODO: combine this with the original keybinding descriptions (those are all in lowercase atm)
 			CheckoutCommit:                    "Checkout commit",
@@ -1153,6 +1490,12 @@ func EnglishTranslationSet() TranslationSet {
 			ForceCheckoutBranch:               "Force checkout branch",
 			DeleteBranch:                      "Delete branch",
 			Merge:                             "Merge",
+			SquashMerge:                       "Squash merge",
+			ForgetRerereResolution:            "Forget rerere resolution",
+			ApplyRerereResolution:             "Apply rerere resolution",
+			EnableRerere:                      "Enable rerere",
+			DisableRerere:                     "Disable rerere",
+			ResolveConflict:                   "Resolve conflict",
 			RebaseBranch:                      "Rebase branch",
 			RenameBranch:                      "Rename branch",
 			SetUnsetUpstream:                  "Set/unset upstream",
@@ -1165,6 +1508,8 @@ func EnglishTranslationSet() TranslationSet {
 			RewordCommit:                      "Reword commit",
 			DropCommit:                        "Drop commit",
 			EditCommit:                        "Edit commit",
+			SplitCommit:                       "Split commit",
+			InsertRebaseTodo:                  "Insert rebase todo",
 			AmendCommit:                       "Amend commit",
 			ResetCommitAuthor:                 "Reset commit author",
 			SetCommitAuthor:                   "Set commit author",
@@ -1198,6 +1543,7 @@ func EnglishTranslationSet() TranslationSet {
 			ExcludeGitIgnoreErr:               "Cannot exclude .gitignore",
 			Commit:                            "Commit",
 			EditFile:                          "Edit file",
+			EditHunk:                          "Edit hunk",
 			Push:                              "Push",
 			Pull:                              "Pull",
 			OpenFile:                          "Open file",
@@ -1218,7 +1564,12 @@ func EnglishTranslationSet() TranslationSet {
 			AddRemote:                         "Add remote",
 			RemoveRemote:                      "Remove remote",
 			UpdateRemote:                      "Update remote",
+			UpdateRemotePushUrl:               "Update remote push URL",
+			FetchAllRemotes:                   "Fetch all remotes",
+			SetRemoteHead:                     "Set remote HEAD",
 			ApplyPatch:                        "Apply patch",
+			ExportPatch:                       "Export patch",
+			ImportPatch:                       "Import patch",
 			Stash:                             "Stash",
 			RemoveSubmodule:                   "Remove submodule",
 			ResetSubmodule:                    "Reset submodule",
@@ -1227,10 +1578,15 @@ func EnglishTranslationSet() TranslationSet {
 			InitialiseSubmodule:               "Initialise submodule",
 			BulkInitialiseSubmodules:          "Bulk initialise submodules",
 			BulkUpdateSubmodules:              "Bulk update submodules",
+			BulkUpdateSubmodulesRecursively:   "Bulk update submodules recursively",
+			BulkSyncSubmodulesRecursively:     "Bulk sync submodules recursively",
 			BulkDeinitialiseSubmodules:        "Bulk deinitialise submodules",
 			UpdateSubmodule:                   "Update submodule",
 			DeleteTag:                         "Delete tag",
 			PushTag:                           "Push tag",
+			DeleteRemoteTag:                   "Delete remote tag",
+			CleanUpBranches:                   "Clean up branches",
+			SetBaseBranch:                     "Set base branch",
 			NukeWorkingTree:                   "Nuke working tree",
 			DiscardUnstagedFileChanges:        "Discard unstaged file changes",
 			RemoveUntrackedFiles:              "Remove untracked files",